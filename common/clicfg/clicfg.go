@@ -4,31 +4,44 @@
 package clicfg
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"net/url"
+	"os"
 	"path/filepath"
 	"slices"
+	"strings"
+	"time"
 
 	"github.com/neo4j/cli/common/clicfg/credentials"
 	"github.com/neo4j/cli/common/clicfg/fileutils"
 	"github.com/neo4j/cli/common/clicfg/projects"
+	"github.com/neo4j/cli/common/clierr"
 	"github.com/spf13/afero"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 	"github.com/spf13/viper"
+	"github.com/tidwall/gjson"
 	"github.com/tidwall/sjson"
 )
 
 var ConfigPrefix string
 
+// Directory holding the config and credentials files, overrides NEO4J_CLI_CONFIG_DIR and the OS specific default when set
+var ConfigDirOverride string
+
+// Name of the project-local config file, which is layered over the user config
+const LocalConfigFileName = ".aura-cli.json"
+
 const (
 	DefaultAuraBaseUrl     = "https://api.neo4j.io"
 	DefaultAuraAuthUrl     = "https://api.neo4j.io/oauth/token"
 	DefaultAuraBetaEnabled = false
+	DefaultAuraCacheTtl    = "1h"
 )
 
-var ValidOutputValues = [3]string{"default", "json", "table"}
+var ValidOutputValues = [4]string{"default", "json", "table", "csv"}
 
 type Config struct {
 	Version     string
@@ -36,8 +49,40 @@ type Config struct {
 	Credentials *credentials.Credentials
 }
 
+// Returns the directory holding the config and credentials files
+func ConfigDir() string {
+	if ConfigDirOverride != "" {
+		return ConfigDirOverride
+	}
+	if configDir, found := os.LookupEnv("NEO4J_CLI_CONFIG_DIR"); found && configDir != "" {
+		return configDir
+	}
+	return filepath.Join(ConfigPrefix, "neo4j", "cli")
+}
+
+// Returns the directory holding cached API responses
+func CacheDir() string {
+	return filepath.Join(ConfigDir(), "cache")
+}
+
+// Returns the value of the --config-dir flag in the given arguments, as the config is loaded before the flags are parsed
+func ConfigDirFromArgs(args []string) string {
+	for i, arg := range args {
+		if arg == "--" {
+			break
+		}
+		if value, found := strings.CutPrefix(arg, "--config-dir="); found {
+			return value
+		}
+		if arg == "--config-dir" && i+1 < len(args) {
+			return args[i+1]
+		}
+	}
+	return ""
+}
+
 func NewConfig(fs afero.Fs, version string) *Config {
-	configPath := filepath.Join(ConfigPrefix, "neo4j", "cli")
+	configPath := ConfigDir()
 	fullConfigPath := filepath.Join(configPath, "config.json")
 
 	Viper := viper.New()
@@ -60,48 +105,141 @@ func NewConfig(fs afero.Fs, version string) *Config {
 		}
 	}
 
+	if err := migrateConfig(fs, fullConfigPath); err != nil {
+		panic(err)
+	}
+
 	if err := Viper.ReadInConfig(); err != nil {
 		fmt.Println("Cannot read config file.")
 		panic(err)
 	}
 
-	credentials := credentials.NewCredentials(fs, ConfigPrefix)
-	projects := projects.NewAuraConfigProjects(fs, fullConfigPath)
+	localConfigPath := findLocalConfig(fs)
+	if localConfigPath != "" {
+		if err := Viper.MergeConfig(bytes.NewReader(readLocalConfig(fs, localConfigPath))); err != nil {
+			fmt.Printf("Cannot read config file %s.\n", localConfigPath)
+			panic(err)
+		}
+	}
+
+	credentials := credentials.NewCredentials(fs, configPath)
+	if credentialName, found := os.LookupEnv("AURA_CREDENTIAL"); found {
+		credentials.Aura.SetOverride(credentialName)
+	}
+	projects := projects.NewAuraConfigProjects(fs, fullConfigPath, localConfigPath)
 
 	return &Config{
 		Version: version,
 		Aura: &AuraConfig{
-			fs:    fs,
-			viper: Viper, pollingOverride: PollingConfig{
+			fs:              fs,
+			viper:           Viper,
+			localConfigPath: localConfigPath,
+			pollingOverride: PollingConfig{
 				MaxRetries: 60,
 				Interval:   20,
 			},
-			ValidConfigKeys: []string{"auth-url", "base-url", "default-tenant", "output", "beta-enabled"},
+			ValidConfigKeys: configKeyNames(AuraConfigKeys),
 			Projects:        projects,
+			boundFlags:      map[string]*pflag.Flag{},
 		},
 		Credentials: credentials,
 	}
 }
 
+// Looks for the project-local config file in the current directory and its parents
+func findLocalConfig(fs afero.Fs) string {
+	dir, err := os.Getwd()
+	if err != nil {
+		return ""
+	}
+
+	for {
+		path := filepath.Join(dir, LocalConfigFileName)
+		if fileutils.FileExists(fs, path) {
+			return path
+		}
+
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return ""
+		}
+		dir = parent
+	}
+}
+
+// The aura keys a project-local config file can set. Keys such as the auth and base URL or the protected resources are left out,
+// so that a checked out repository cannot send credentials to another host or lift safety settings.
+var localConfigKeys = []string{"default-tenant", "output"}
+
+// Returns the content of the local config file without the keys it cannot set, warning about those keys on stderr
+func readLocalConfig(fs afero.Fs, path string) []byte {
+	local := map[string]any{}
+	if data := fileutils.ReadFileSafe(fs, path); len(bytes.TrimSpace(data)) > 0 {
+		if err := json.Unmarshal(data, &local); err != nil {
+			fmt.Printf("Cannot read config file %s.\n", path)
+			panic(err)
+		}
+	}
+
+	allowed := map[string]any{}
+	ignored := []string{}
+	for key, value := range local {
+		switch key {
+		case "aura-projects":
+			allowed[key] = value
+		case "aura":
+			auraValues, _ := value.(map[string]any)
+			allowedAuraValues := map[string]any{}
+			for auraKey, auraValue := range auraValues {
+				if slices.Contains(localConfigKeys, auraKey) {
+					allowedAuraValues[auraKey] = auraValue
+				} else {
+					ignored = append(ignored, fmt.Sprintf("aura.%s", auraKey))
+				}
+			}
+			allowed[key] = allowedAuraValues
+		default:
+			ignored = append(ignored, key)
+		}
+	}
+	if len(ignored) > 0 {
+		slices.Sort(ignored)
+		fmt.Fprintf(os.Stderr, "Warning: ignoring %s in %s, a local config file can only set aura.default-tenant, aura.output and aura-projects\n", strings.Join(ignored, ", "), path)
+	}
+
+	data, err := json.Marshal(allowed)
+	if err != nil {
+		panic(err)
+	}
+	return data
+}
+
 func bindEnvironmentVariables(Viper *viper.Viper) {
-	Viper.BindEnv("aura.base-url", "AURA_BASE_URL")
-	Viper.BindEnv("aura.auth-url", "AURA_AUTH_URL")
+	for _, key := range AuraConfigKeys {
+		if key.EnvVar != "" {
+			Viper.BindEnv(fmt.Sprintf("aura.%s", key.Name), key.EnvVar)
+		}
+	}
 }
 
 func setDefaultValues(Viper *viper.Viper) {
-	Viper.SetDefault("aura.base-url", DefaultAuraBaseUrl)
-	Viper.SetDefault("aura.auth-url", DefaultAuraAuthUrl)
-	Viper.SetDefault("aura.output", "default")
-	Viper.SetDefault("aura.beta-enabled", DefaultAuraBetaEnabled)
+	Viper.SetDefault("version", CurrentConfigVersion)
+	for _, key := range AuraConfigKeys {
+		if key.Default != nil {
+			Viper.SetDefault(fmt.Sprintf("aura.%s", key.Name), key.Default)
+		}
+	}
 	Viper.SetDefault("aura-projects", projects.AuraProjects{Default: "", Projects: map[string]*projects.AuraProject{}})
 }
 
 type AuraConfig struct {
 	viper           *viper.Viper
 	fs              afero.Fs
+	localConfigPath string
 	pollingOverride PollingConfig
 	ValidConfigKeys []string
 	Projects        *projects.AuraConfigProjects
+	boundFlags      map[string]*pflag.Flag
 }
 
 type PollingConfig struct {
@@ -117,25 +255,76 @@ func (config *AuraConfig) Get(key string) interface{} {
 	return config.viper.Get(fmt.Sprintf("aura.%s", key))
 }
 
-func (config *AuraConfig) Set(key string, value string) {
+// Validates the value for the given key and converts it to the value stored in the config file
+func (config *AuraConfig) ParseValue(key string, value string) (any, error) {
+	configKey, ok := findConfigKey(key)
+	if !ok {
+		return nil, clierr.NewUsageError("invalid config key specified: %s", key)
+	}
+	return configKey.Parse(value)
+}
+
+func (config *AuraConfig) Set(key string, value string) error {
+	parsedValue, err := config.ParseValue(key, value)
+	if err != nil {
+		return err
+	}
+
 	filename := config.viper.ConfigFileUsed()
 	data := fileutils.ReadFileSafe(config.fs, filename)
 
-	updateConfig, err := sjson.Set(string(data), fmt.Sprintf("aura.%s", key), value)
+	updateConfig, err := sjson.Set(string(data), fmt.Sprintf("aura.%s", key), parsedValue)
 	if err != nil {
-		panic(err)
+		return err
 	}
 
-	if key == "base-url" {
-		updatedAuraBaseUrl := config.auraBaseUrlOnConfigChange(value)
-		intermediateUpdateConfig, err := sjson.Set(string(updateConfig), "aura.base-url", updatedAuraBaseUrl)
-		if err != nil {
-			panic(err)
-		}
-		updateConfig = intermediateUpdateConfig
+	fileutils.WriteFile(config.fs, filename, []byte(updateConfig))
+	return nil
+}
+
+// Removes the key from the config file, so that its default value is used
+func (config *AuraConfig) Unset(key string) error {
+	if !config.IsValidConfigKey(key) {
+		return clierr.NewUsageError("invalid config key specified: %s", key)
+	}
+
+	filename := config.viper.ConfigFileUsed()
+	data := fileutils.ReadFileSafe(config.fs, filename)
+
+	updateConfig, err := sjson.Delete(string(data), fmt.Sprintf("aura.%s", key))
+	if err != nil {
+		return err
 	}
 
 	fileutils.WriteFile(config.fs, filename, []byte(updateConfig))
+	return nil
+}
+
+// Returns where the current value of the key comes from, one of the ConfigSource constants
+func (config *AuraConfig) Source(key string) string {
+	if flag, ok := config.boundFlags[key]; ok && flag.Changed {
+		return ConfigSourceFlag
+	}
+
+	if configKey, ok := findConfigKey(key); ok && configKey.EnvVar != "" {
+		if _, found := os.LookupEnv(configKey.EnvVar); found {
+			return ConfigSourceEnv
+		}
+	}
+
+	if config.localConfigPath != "" && slices.Contains(localConfigKeys, key) {
+		data := fileutils.ReadFileSafe(config.fs, config.localConfigPath)
+		if gjson.GetBytes(data, fmt.Sprintf("aura.%s", key)).Exists() {
+			return ConfigSourceLocal
+		}
+	}
+
+	data := fileutils.ReadFileSafe(config.fs, config.viper.ConfigFileUsed())
+	if gjson.GetBytes(data, fmt.Sprintf("aura.%s", key)).Exists() {
+		return ConfigSourceFile
+	}
+
+	return ConfigSourceDefault
 }
 
 func (config *AuraConfig) PrintAuraConfig(cmd *cobra.Command) {
@@ -157,8 +346,8 @@ func (config *AuraConfig) print(cmd *cobra.Command, path string) {
 
 func (config *AuraConfig) BaseUrl() string {
 	originalUrl := config.viper.GetString("aura.base-url")
-	//Existing users have base url configs with trailing path /v1.
-	//To make it backward compatible, we allow old config and clear up by removing trailing path /v1 in the url
+	//Trailing paths such as /v1 are removed from the config file by migrateConfig, but can still be set through the
+	//environment variable or flag, so they are removed here as well
 	return removePathParametersFromUrl(originalUrl)
 }
 
@@ -182,6 +371,7 @@ func (config *AuraConfig) BindBaseUrl(flag *pflag.Flag) {
 	if err := config.viper.BindPFlag("aura.base-url", flag); err != nil {
 		panic(err)
 	}
+	config.boundFlags["base-url"] = flag
 }
 
 func (config *AuraConfig) AuthUrl() string {
@@ -192,6 +382,7 @@ func (config *AuraConfig) BindAuthUrl(flag *pflag.Flag) {
 	if err := config.viper.BindPFlag("aura.auth-url", flag); err != nil {
 		panic(err)
 	}
+	config.boundFlags["auth-url"] = flag
 }
 
 func (config *AuraConfig) Output() string {
@@ -202,6 +393,7 @@ func (config *AuraConfig) BindOutput(flag *pflag.Flag) {
 	if err := config.viper.BindPFlag("aura.output", flag); err != nil {
 		panic(err)
 	}
+	config.boundFlags["output"] = flag
 }
 
 func (config *AuraConfig) AuraBetaEnabled() bool {
@@ -212,6 +404,30 @@ func (config *AuraConfig) DefaultTenant() string {
 	return config.viper.GetString("aura.default-tenant")
 }
 
+// Returns how long cached data is valid for, zero when caching is disabled
+func (config *AuraConfig) CacheTtl() time.Duration {
+	ttl, err := time.ParseDuration(config.viper.GetString("aura.cache-ttl"))
+	if err != nil {
+		return 0
+	}
+	return ttl
+}
+
+// Returns the instance IDs and instance name regular expressions of production instances
+func (config *AuraConfig) ProductionInstances() []string {
+	return config.viper.GetStringSlice("aura.production-instances")
+}
+
+// Returns the IDs and name regular expressions of the resources destructive commands refuse to change without --force
+func (config *AuraConfig) ProtectedResources() []string {
+	return config.viper.GetStringSlice("aura.protected-resources")
+}
+
+// Returns the path of the project-local config file in use, or an empty string if there is none
+func (config *AuraConfig) LocalConfigPath() string {
+	return config.localConfigPath
+}
+
 func (config *AuraConfig) Fs() afero.Fs {
 	return config.fs
 }
@@ -226,10 +442,3 @@ func (config *AuraConfig) SetPollingConfig(maxRetries int, interval int) {
 		Interval:   interval,
 	}
 }
-
-func (config *AuraConfig) auraBaseUrlOnConfigChange(url string) string {
-	if url == "" {
-		return DefaultAuraBaseUrl
-	}
-	return removePathParametersFromUrl(url)
-}
@@ -7,11 +7,15 @@ import (
 	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/neo4j/cli/common/clicfg"
 	"github.com/neo4j/cli/test/utils/testfs"
+	"github.com/spf13/afero"
 	"github.com/stretchr/testify/assert"
+	"github.com/tidwall/gjson"
 )
 
 func TestGetAuraBaseUrlConfigRemovesTrailingPath(t *testing.T) {
@@ -44,3 +48,178 @@ func TestGetAuraBaseUrlConfigRemovesTrailingPath(t *testing.T) {
 	//The path parameter will be removed from GET base url
 	assert.Equal(t, server.URL, cfg.Aura.BaseUrl())
 }
+
+func TestConfigMigratesLegacyValues(t *testing.T) {
+	fs, err := testfs.GetTestFs(`{
+		"aura": {
+			"base-url": "https://api.neo4j.io/v1",
+			"beta-enabled": "true"
+			}
+		}`, "{}")
+	assert.Nil(t, err)
+
+	cfg := clicfg.NewConfig(fs, "test")
+
+	config, err := testfs.GetTestConfig(fs)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(clicfg.CurrentConfigVersion), gjson.Get(config, "version").Int())
+	assert.Equal(t, "https://api.neo4j.io", gjson.Get(config, "aura.base-url").Value())
+	assert.Equal(t, true, gjson.Get(config, "aura.beta-enabled").Value())
+	assert.True(t, cfg.Aura.AuraBetaEnabled())
+}
+
+func TestConfigMigrationSkipsNewerVersions(t *testing.T) {
+	original := `{"version": 99, "aura": {"base-url": "https://api.neo4j.io/v1"}}`
+	fs, err := testfs.GetTestFs(original, "{}")
+	assert.Nil(t, err)
+
+	clicfg.NewConfig(fs, "test")
+
+	config, err := testfs.GetTestConfig(fs)
+	assert.Nil(t, err)
+	assert.Equal(t, original, config)
+}
+
+func TestConfigMigrationOfInvalidVersion(t *testing.T) {
+	fs, err := testfs.GetTestFs(`{"version": -3, "aura": {"base-url": "https://api.neo4j.io/v1"}}`, "{}")
+	assert.Nil(t, err)
+
+	clicfg.NewConfig(fs, "test")
+
+	config, err := testfs.GetTestConfig(fs)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(clicfg.CurrentConfigVersion), gjson.Get(config, "version").Int())
+	assert.Equal(t, "https://api.neo4j.io", gjson.Get(config, "aura.base-url").Value())
+}
+
+func TestNewConfigFileHasCurrentVersion(t *testing.T) {
+	fs, err := testfs.GetTestFs("", "")
+	assert.Nil(t, err)
+
+	clicfg.NewConfig(fs, "test")
+
+	config, err := testfs.GetTestConfig(fs)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(clicfg.CurrentConfigVersion), gjson.Get(config, "version").Int())
+}
+
+func TestConfigDirFromArgs(t *testing.T) {
+	assert.Equal(t, "/tmp/a", clicfg.ConfigDirFromArgs([]string{"instance", "list", "--config-dir", "/tmp/a"}))
+	assert.Equal(t, "/tmp/b", clicfg.ConfigDirFromArgs([]string{"--config-dir=/tmp/b", "instance", "list"}))
+	assert.Equal(t, "", clicfg.ConfigDirFromArgs([]string{"instance", "list"}))
+	assert.Equal(t, "", clicfg.ConfigDirFromArgs([]string{"instance", "--", "--config-dir", "/tmp/c"}))
+	assert.Equal(t, "", clicfg.ConfigDirFromArgs([]string{"instance", "list", "--config-dir"}))
+}
+
+func TestConfigDirFromEnvironment(t *testing.T) {
+	t.Setenv("NEO4J_CLI_CONFIG_DIR", "/tmp/neo4j-cli")
+
+	assert.Equal(t, "/tmp/neo4j-cli", clicfg.ConfigDir())
+
+	fs, err := testfs.GetTestFs("", "")
+	assert.Nil(t, err)
+
+	clicfg.NewConfig(fs, "test")
+
+	exists, err := afero.Exists(fs, filepath.Join("/tmp/neo4j-cli", "config.json"))
+	assert.Nil(t, err)
+	assert.True(t, exists)
+	exists, err = afero.Exists(fs, filepath.Join("/tmp/neo4j-cli", "credentials.json"))
+	assert.Nil(t, err)
+	assert.True(t, exists)
+}
+
+func TestConfigDirOverride(t *testing.T) {
+	t.Setenv("NEO4J_CLI_CONFIG_DIR", "/tmp/neo4j-cli")
+	clicfg.ConfigDirOverride = "/tmp/override"
+	defer func() { clicfg.ConfigDirOverride = "" }()
+
+	assert.Equal(t, "/tmp/override", clicfg.ConfigDir())
+}
+
+func TestLocalConfigIsLayeredOverUserConfig(t *testing.T) {
+	fs, err := testfs.GetTestFs(`{
+		"aura": {
+			"output": "json",
+			"default-tenant": "user-tenant"
+		},
+		"aura-projects": {
+			"default": "user-project",
+			"projects": {
+				"user-project": {"organization-id": "user-org", "project-id": "user-proj"}
+			}
+		}
+	}`, "{}")
+	assert.Nil(t, err)
+
+	cwd, err := os.Getwd()
+	assert.Nil(t, err)
+	// The local config file is found in a parent of the current directory
+	localConfigPath := filepath.Join(filepath.Dir(cwd), clicfg.LocalConfigFileName)
+	err = afero.WriteFile(fs, localConfigPath, []byte(`{
+		"aura": {
+			"default-tenant": "repo-tenant"
+		},
+		"aura-projects": {
+			"default": "repo-project",
+			"projects": {
+				"repo-project": {"organization-id": "repo-org", "project-id": "repo-proj"}
+			}
+		}
+	}`), 0600)
+	assert.Nil(t, err)
+
+	cfg := clicfg.NewConfig(fs, "test")
+
+	assert.Equal(t, localConfigPath, cfg.Aura.LocalConfigPath())
+	assert.Equal(t, "repo-tenant", cfg.Aura.DefaultTenant())
+	assert.Equal(t, "json", cfg.Aura.Output())
+	assert.Equal(t, clicfg.ConfigSourceLocal, cfg.Aura.Source("default-tenant"))
+	assert.Equal(t, clicfg.ConfigSourceFile, cfg.Aura.Source("output"))
+
+	project, err := cfg.Aura.Projects.Default()
+	assert.Nil(t, err)
+	assert.Equal(t, "repo-org", project.OrganizationId)
+	assert.Equal(t, "repo-proj", project.ProjectId)
+
+	// Changes are only written to the user config
+	assert.Nil(t, cfg.Aura.Set("default-tenant", "new-tenant"))
+	config, err := testfs.GetTestConfig(fs)
+	assert.Nil(t, err)
+	assert.Equal(t, "new-tenant", gjson.Get(config, "aura.default-tenant").String())
+	local, err := afero.ReadFile(fs, localConfigPath)
+	assert.Nil(t, err)
+	assert.Equal(t, "repo-tenant", gjson.GetBytes(local, "aura.default-tenant").String())
+}
+
+func TestLocalConfigCannotSetUrlsOrSafetyLists(t *testing.T) {
+	fs, err := testfs.GetTestFs(`{
+		"aura": {
+			"auth-url": "https://api.neo4j.io/oauth/token",
+			"base-url": "https://api.neo4j.io/v1",
+			"protected-resources": ["^prod-"]
+		}
+	}`, "{}")
+	assert.Nil(t, err)
+
+	cwd, err := os.Getwd()
+	assert.Nil(t, err)
+	localConfigPath := filepath.Join(cwd, clicfg.LocalConfigFileName)
+	err = afero.WriteFile(fs, localConfigPath, []byte(`{
+		"aura": {
+			"default-tenant": "repo-tenant",
+			"auth-url": "https://attacker.example/oauth/token",
+			"base-url": "https://attacker.example/v1",
+			"protected-resources": []
+		}
+	}`), 0600)
+	assert.Nil(t, err)
+
+	cfg := clicfg.NewConfig(fs, "test")
+
+	assert.Equal(t, "repo-tenant", cfg.Aura.DefaultTenant())
+	assert.Equal(t, "https://api.neo4j.io/oauth/token", cfg.Aura.AuthUrl())
+	assert.Equal(t, "https://api.neo4j.io", cfg.Aura.BaseUrl())
+	assert.Equal(t, []string{"^prod-"}, cfg.Aura.ProtectedResources())
+	assert.Equal(t, clicfg.ConfigSourceFile, cfg.Aura.Source("base-url"))
+}
@@ -15,6 +15,7 @@ type AuraCredentials struct {
 	DefaultCredential string            `json:"default-credential"`
 	Credentials       []*AuraCredential `json:"credentials"`
 	onUpdate          func()
+	override          string
 }
 
 func (c *AuraCredentials) List() []*AuraCredential {
@@ -88,6 +89,23 @@ func (c *AuraCredentials) GetDefault() (*AuraCredential, error) {
 	return c.Get(c.DefaultCredential)
 }
 
+// Sets the credential to use for the current invocation instead of the default credential, without persisting it
+func (c *AuraCredentials) SetOverride(name string) {
+	c.override = name
+}
+
+func (c *AuraCredentials) Override() string {
+	return c.override
+}
+
+// Returns the credential set for the current invocation if there is one, otherwise the default credential
+func (c *AuraCredentials) GetCurrent() (*AuraCredential, error) {
+	if c.override != "" {
+		return c.Get(c.override)
+	}
+	return c.GetDefault()
+}
+
 func (c *AuraCredentials) Get(name string) (*AuraCredential, error) {
 	for _, credential := range c.Credentials {
 		if credential.Name == name {
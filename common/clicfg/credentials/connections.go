@@ -0,0 +1,59 @@
+// Copyright (c) "Neo4j"
+// Neo4j Sweden AB [http://neo4j.com]
+
+package credentials
+
+import (
+	"github.com/neo4j/cli/common/clierr"
+)
+
+// Database credentials of instances, kept next to the Aura credentials as they are secrets as well
+type Connections struct {
+	Connections []*Connection `json:"connections"`
+	onUpdate    func()
+}
+
+type Connection struct {
+	InstanceId string `json:"instance-id"`
+	Uri        string `json:"uri"`
+	Username   string `json:"username"`
+	Password   string `json:"password"`
+}
+
+func (c *Connections) List() []*Connection {
+	return c.Connections
+}
+
+func (c *Connections) Get(instanceId string) (*Connection, error) {
+	for _, connection := range c.Connections {
+		if connection.InstanceId == instanceId {
+			return connection, nil
+		}
+	}
+	return nil, clierr.NewUsageError("could not find a stored connection for instance %s", instanceId)
+}
+
+// Stores the connection, replacing any connection stored for the same instance
+func (c *Connections) Set(connection *Connection) {
+	for i, existing := range c.Connections {
+		if existing.InstanceId == connection.InstanceId {
+			c.Connections[i] = connection
+			c.onUpdate()
+			return
+		}
+	}
+
+	c.Connections = append(c.Connections, connection)
+	c.onUpdate()
+}
+
+func (c *Connections) Remove(instanceId string) error {
+	for i, connection := range c.Connections {
+		if connection.InstanceId == instanceId {
+			c.Connections = append(c.Connections[:i], c.Connections[i+1:]...)
+			c.onUpdate()
+			return nil
+		}
+	}
+	return clierr.NewUsageError("could not find a stored connection for instance %s", instanceId)
+}
@@ -12,17 +12,19 @@ import (
 )
 
 type CredentialsFile struct {
-	Aura *AuraCredentials `json:"aura"`
+	Aura        *AuraCredentials `json:"aura"`
+	Connections *Connections     `json:"neo4j,omitempty"`
 }
 
 type Credentials struct {
-	fs       afero.Fs
-	Aura     *AuraCredentials
-	filePath string
+	fs          afero.Fs
+	Aura        *AuraCredentials
+	Connections *Connections
+	filePath    string
 }
 
-func NewCredentials(fs afero.Fs, configPrefix string) *Credentials {
-	configPath := filepath.Join(configPrefix, "neo4j", "cli", "credentials.json")
+func NewCredentials(fs afero.Fs, configDir string) *Credentials {
+	configPath := filepath.Join(configDir, "credentials.json")
 	c := Credentials{
 		fs:       fs,
 		filePath: configPath,
@@ -40,6 +42,10 @@ func (c *Credentials) load() {
 			Credentials: []*AuraCredential{},
 			onUpdate:    c.save,
 		},
+		Connections: &Connections{
+			Connections: []*Connection{},
+			onUpdate:    c.save,
+		},
 	}
 	if fileHasData {
 		if err := json.Unmarshal(data, &credentials); err != nil {
@@ -48,6 +54,7 @@ func (c *Credentials) load() {
 	}
 
 	c.Aura = credentials.Aura
+	c.Connections = credentials.Connections
 
 	if !fileHasData {
 		c.save()
@@ -55,9 +62,15 @@ func (c *Credentials) load() {
 }
 
 func (c *Credentials) save() {
-	data, err := json.Marshal(CredentialsFile{
+	file := CredentialsFile{
 		Aura: c.Aura,
-	})
+	}
+	// Only written once a connection has been stored, to leave existing credential files unchanged
+	if len(c.Connections.Connections) > 0 {
+		file.Connections = c.Connections
+	}
+
+	data, err := json.Marshal(file)
 	if err != nil {
 		panic(err)
 	}
@@ -0,0 +1,81 @@
+// Copyright (c) "Neo4j"
+// Neo4j Sweden AB [http://neo4j.com]
+
+package clicfg
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/neo4j/cli/common/clicfg/fileutils"
+	"github.com/spf13/afero"
+	"github.com/tidwall/gjson"
+	"github.com/tidwall/sjson"
+)
+
+// Version of the config file layout written by this version of the CLI
+const CurrentConfigVersion = 1
+
+// Each migration upgrades the config file from the version at its index to the next version
+var configMigrations = []func(data string) (string, error){
+	migrateLegacyValues,
+}
+
+// Upgrades the config file at the given path to the current version, config files written by a newer version of the CLI are left untouched
+func migrateConfig(fs afero.Fs, path string) error {
+	data := string(fileutils.ReadFileSafe(fs, path))
+
+	version := int(gjson.Get(data, "version").Int())
+	if version >= CurrentConfigVersion {
+		return nil
+	}
+	// An invalid version in an edited config file is migrated from the start, which every migration supports
+	if version < 0 {
+		version = 0
+	}
+
+	for _, migrate := range configMigrations[version:] {
+		migrated, err := migrate(data)
+		if err != nil {
+			return fmt.Errorf("cannot migrate config file %s from version %d: %w", path, version, err)
+		}
+		data = migrated
+	}
+
+	data, err := sjson.Set(data, "version", CurrentConfigVersion)
+	if err != nil {
+		return err
+	}
+
+	fileutils.WriteFile(fs, path, []byte(data))
+	return nil
+}
+
+// Removes the trailing /v1 path older versions stored in base-url, and converts beta-enabled values stored as strings to booleans
+func migrateLegacyValues(data string) (string, error) {
+	baseUrl := gjson.Get(data, "aura.base-url")
+	if baseUrl.Type == gjson.String && baseUrl.String() != "" {
+		parsedUrl, err := url.Parse(baseUrl.String())
+		if err == nil && parsedUrl.Path != "" {
+			data, err = sjson.Set(data, "aura.base-url", fmt.Sprintf("%s://%s", parsedUrl.Scheme, parsedUrl.Host))
+			if err != nil {
+				return "", err
+			}
+		}
+	}
+
+	betaEnabled := gjson.Get(data, "aura.beta-enabled")
+	if betaEnabled.Type == gjson.String {
+		parsed, err := strconv.ParseBool(betaEnabled.String())
+		if err != nil {
+			parsed = DefaultAuraBetaEnabled
+		}
+		data, err = sjson.Set(data, "aura.beta-enabled", parsed)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	return data, nil
+}
@@ -14,8 +14,9 @@ import (
 )
 
 type AuraConfigProjects struct {
-	fs       afero.Fs
-	filePath string
+	fs            afero.Fs
+	filePath      string
+	localFilePath string
 }
 
 type ConfigAuraProjects struct {
@@ -32,8 +33,10 @@ type AuraProject struct {
 	ProjectId      string `json:"project-id"`
 }
 
-func NewAuraConfigProjects(fs afero.Fs, filePath string) *AuraConfigProjects {
-	return &AuraConfigProjects{fs: fs, filePath: filePath}
+// Projects are added to and removed from the config file at filePath, projects and the default project in the
+// optional project-local config file at localFilePath take precedence when reading
+func NewAuraConfigProjects(fs afero.Fs, filePath string, localFilePath string) *AuraConfigProjects {
+	return &AuraConfigProjects{fs: fs, filePath: filePath, localFilePath: localFilePath}
 }
 
 func (p *AuraConfigProjects) Add(name string, organizationId string, projectId string) error {
@@ -114,9 +117,7 @@ func (p *AuraConfigProjects) SetDefault(name string) (*AuraProject, error) {
 }
 
 func (p *AuraConfigProjects) Default() (*AuraProject, error) {
-	data := fileutils.ReadFileSafe(p.fs, p.filePath)
-
-	projects, err := p.projectsFrom(data)
+	projects, err := p.List()
 	if err != nil {
 		return nil, err
 	}
@@ -128,6 +129,48 @@ func (p *AuraConfigProjects) Default() (*AuraProject, error) {
 	return &AuraProject{}, nil
 }
 
+func (p *AuraConfigProjects) List() (*AuraProjects, error) {
+	data := fileutils.ReadFileSafe(p.fs, p.filePath)
+
+	projects, err := p.projectsFrom(data)
+	if err != nil {
+		return nil, err
+	}
+
+	if projects == nil {
+		projects = &AuraProjects{Default: "", Projects: map[string]*AuraProject{}}
+	}
+
+	return p.withLocalProjects(projects)
+}
+
+func (p *AuraConfigProjects) withLocalProjects(projects *AuraProjects) (*AuraProjects, error) {
+	if p.localFilePath == "" {
+		return projects, nil
+	}
+
+	localProjects, err := p.projectsFrom(fileutils.ReadFileSafe(p.fs, p.localFilePath))
+	if err != nil {
+		return nil, err
+	}
+	if localProjects == nil {
+		return projects, nil
+	}
+
+	merged := &AuraProjects{Default: projects.Default, Projects: map[string]*AuraProject{}}
+	for name, project := range projects.Projects {
+		merged.Projects[name] = project
+	}
+	for name, project := range localProjects.Projects {
+		merged.Projects[name] = project
+	}
+	if localProjects.Default != "" {
+		merged.Default = localProjects.Default
+	}
+
+	return merged, nil
+}
+
 func (p *AuraConfigProjects) projectsFrom(data []byte) (*AuraProjects, error) {
 	auraProjectConfig := ConfigAuraProjects{}
 	if err := json.Unmarshal(data, &auraProjectConfig); err != nil {
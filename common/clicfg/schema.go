@@ -0,0 +1,152 @@
+// Copyright (c) "Neo4j"
+// Neo4j Sweden AB [http://neo4j.com]
+
+package clicfg
+
+import (
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/neo4j/cli/common/clierr"
+)
+
+// Where the current value of a configuration key comes from, in increasing order of precedence
+const (
+	ConfigSourceDefault = "default"
+	ConfigSourceFile    = "file"
+	ConfigSourceLocal   = "local"
+	ConfigSourceEnv     = "env"
+	ConfigSourceFlag    = "flag"
+)
+
+type ConfigKey struct {
+	Name        string
+	Description string
+	// Value used when the key is not set, nil if the key has no default value
+	Default any
+	// Environment variable that overrides the value in the config file, if any
+	EnvVar string
+	// Validates a value given on the command line and converts it to the value stored in the config file
+	Parse func(value string) (any, error)
+}
+
+var AuraConfigKeys = []ConfigKey{
+	{
+		Name:        "auth-url",
+		Description: "URL used to retrieve access tokens for the Aura API",
+		Default:     DefaultAuraAuthUrl,
+		EnvVar:      "AURA_AUTH_URL",
+		Parse:       parseString,
+	},
+	{
+		Name:        "base-url",
+		Description: "Base URL of the Aura API",
+		Default:     DefaultAuraBaseUrl,
+		EnvVar:      "AURA_BASE_URL",
+		Parse:       parseBaseUrl,
+	},
+	{
+		Name:        "default-tenant",
+		Description: "Tenant/project ID used by commands when --tenant-id is not set",
+		Parse:       parseString,
+	},
+	{
+		Name:        "output",
+		Description: "Format to print console output in, from a choice of [" + strings.Join(ValidOutputValues[:], ", ") + "]",
+		Default:     "default",
+		Parse:       parseOutput,
+	},
+	{
+		Name:        "beta-enabled",
+		Description: "Enables beta commands and the beta versions of the Aura API",
+		Default:     DefaultAuraBetaEnabled,
+		Parse:       parseBool,
+	},
+	{
+		Name:        "cache-ttl",
+		Description: "How long data such as the instance configurations of tenants is cached for, e.g. 30m, 0 disables the cache",
+		Default:     DefaultAuraCacheTtl,
+		Parse:       parseDuration,
+	},
+	{
+		Name:        "production-instances",
+		Description: "Comma separated instance IDs or regular expressions matching instance names of production instances, which are snapshotted before being overwritten or deleted",
+		Parse:       parsePatternList,
+	},
+	{
+		Name:        "protected-resources",
+		Description: "Comma separated IDs or regular expressions matching names of resources that destructive commands refuse to change without --force",
+		Parse:       parsePatternList,
+	},
+}
+
+func configKeyNames(keys []ConfigKey) []string {
+	names := make([]string, 0, len(keys))
+	for _, key := range keys {
+		names = append(names, key.Name)
+	}
+	return names
+}
+
+func findConfigKey(name string) (ConfigKey, bool) {
+	index := slices.IndexFunc(AuraConfigKeys, func(key ConfigKey) bool {
+		return key.Name == name
+	})
+	if index == -1 {
+		return ConfigKey{}, false
+	}
+	return AuraConfigKeys[index], true
+}
+
+func parseString(value string) (any, error) {
+	return value, nil
+}
+
+func parseBaseUrl(value string) (any, error) {
+	if value == "" {
+		return DefaultAuraBaseUrl, nil
+	}
+	return removePathParametersFromUrl(value), nil
+}
+
+func parseOutput(value string) (any, error) {
+	if !slices.Contains(ValidOutputValues[:], value) {
+		return nil, clierr.NewUsageError("invalid output value specified: %s", value)
+	}
+	return value, nil
+}
+
+func parseDuration(value string) (any, error) {
+	parsed, err := time.ParseDuration(value)
+	if err != nil || parsed < 0 {
+		return nil, clierr.NewUsageError("invalid duration value specified: %s", value)
+	}
+	return value, nil
+}
+
+// Splits a comma separated list of IDs or regular expressions, an empty value clears the list
+func parsePatternList(value string) (any, error) {
+	patterns := []string{}
+	for _, pattern := range strings.Split(value, ",") {
+		pattern = strings.TrimSpace(pattern)
+		if pattern == "" {
+			continue
+		}
+		if _, err := regexp.Compile(pattern); err != nil {
+			return nil, clierr.NewUsageError("invalid regular expression specified: %s", pattern)
+		}
+		patterns = append(patterns, pattern)
+	}
+	return patterns, nil
+}
+
+func parseBool(value string) (any, error) {
+	parsed, err := strconv.ParseBool(value)
+	if err != nil {
+		return nil, clierr.NewUsageError("invalid boolean value specified: %s", value)
+	}
+	return parsed, nil
+}
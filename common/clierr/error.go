@@ -3,7 +3,10 @@
 
 package clierr
 
-import "fmt"
+import (
+	"errors"
+	"fmt"
+)
 
 // Usage Error, require feedback
 func NewUsageError(msg string, a ...any) error {
@@ -19,3 +22,35 @@ func NewUpstreamError(msg string, a ...any) error {
 func NewFatalError(msg string, a ...any) error {
 	return fmt.Errorf(msg, a...)
 }
+
+// Error that should terminate the CLI with a specific exit code
+type ExitError struct {
+	Code int
+	err  error
+}
+
+func NewExitError(code int, msg string, a ...any) error {
+	return &ExitError{Code: code, err: fmt.Errorf(msg, a...)}
+}
+
+func (e *ExitError) Error() string {
+	return e.err.Error()
+}
+
+func (e *ExitError) Unwrap() error {
+	return e.err
+}
+
+// Returns the exit code the CLI should terminate with for the given error
+func ExitCode(err error) int {
+	if err == nil {
+		return 0
+	}
+
+	var exitErr *ExitError
+	if errors.As(err, &exitErr) {
+		return exitErr.Code
+	}
+
+	return 1
+}
@@ -4,31 +4,50 @@
 package aura
 
 import (
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/subcommands/apply"
 	"github.com/neo4j/cli/neo4j-cli/aura/internal/subcommands/deployment"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/subcommands/export"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/subcommands/exporter"
 	"github.com/neo4j/cli/neo4j-cli/aura/internal/subcommands/graphanalytics"
 	_import "github.com/neo4j/cli/neo4j-cli/aura/internal/subcommands/import"
 	"github.com/spf13/cobra"
 
 	"github.com/neo4j/cli/common/clicfg"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/flags"
 	"github.com/neo4j/cli/neo4j-cli/aura/internal/subcommands/config"
 	"github.com/neo4j/cli/neo4j-cli/aura/internal/subcommands/credential"
 	"github.com/neo4j/cli/neo4j-cli/aura/internal/subcommands/customermanagedkey"
 	"github.com/neo4j/cli/neo4j-cli/aura/internal/subcommands/dataapi"
 	"github.com/neo4j/cli/neo4j-cli/aura/internal/subcommands/instance"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/subcommands/inventory"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/subcommands/scheduler"
 	"github.com/neo4j/cli/neo4j-cli/aura/internal/subcommands/tenant"
 )
 
 func NewCmd(cfg *clicfg.Config) *cobra.Command {
 	cmd := &cobra.Command{
-		Use:     "aura-cli",
-		Short:   "Allows you to programmatically provision and manage your Aura resources",
+		Use:   "aura-cli",
+		Short: "Allows you to programmatically provision and manage your Aura resources",
+		Long: `Allows you to programmatically provision and manage your Aura resources.
+
+Wherever an instance, tenant, customer managed key, session, Data API or deployment ID is expected, you can also give the name of the resource, either as name:<name> or as a plain name when it does not look like an ID. Names are resolved through the corresponding list command and must be unique.`,
 		Version: cfg.Version,
 	}
 
+	// The config directory is applied before the flags are parsed, see clicfg.ConfigDirFromArgs
+	cmd.PersistentFlags().String("config-dir", "", "Directory holding the config and credentials files, can also be set with the NEO4J_CLI_CONFIG_DIR environment variable")
+	cmd.PersistentFlags().Var(flags.NewCredential(cfg.Credentials.Aura), "credential", "Name of the credential to use for this command instead of the default credential, can also be set with the AURA_CREDENTIAL environment variable")
+
+	cmd.AddCommand(apply.NewCmd(cfg))
+	cmd.AddCommand(apply.NewPlanCmd(cfg))
 	cmd.AddCommand(config.NewCmd(cfg))
 	cmd.AddCommand(credential.NewCmd(cfg))
 	cmd.AddCommand(customermanagedkey.NewCmd(cfg))
+	cmd.AddCommand(export.NewCmd(cfg))
+	cmd.AddCommand(exporter.NewCmd(cfg))
 	cmd.AddCommand(instance.NewCmd(cfg))
+	cmd.AddCommand(inventory.NewCmd(cfg))
+	cmd.AddCommand(scheduler.NewCmd(cfg))
 	cmd.AddCommand(tenant.NewCmd(cfg))
 	cmd.AddCommand(graphanalytics.NewCmd(cfg))
 	if cfg.Aura.AuraBetaEnabled() {
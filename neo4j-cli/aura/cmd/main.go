@@ -8,6 +8,7 @@ import (
 	"os"
 
 	"github.com/neo4j/cli/common/clicfg"
+	"github.com/neo4j/cli/common/clierr"
 	"github.com/neo4j/cli/neo4j-cli/aura"
 	"github.com/spf13/afero"
 )
@@ -23,10 +24,13 @@ func main() {
 		}
 	}()
 
+	clicfg.ConfigDirOverride = clicfg.ConfigDirFromArgs(os.Args[1:])
 	cfg := clicfg.NewConfig(afero.NewOsFs(), Version)
 
 	cmd := aura.NewCmd(cfg)
 	cmd.SetOut(os.Stdout)
 	cmd.SetErr(os.Stderr)
-	cmd.Execute()
+	if err := cmd.Execute(); err != nil {
+		os.Exit(clierr.ExitCode(err))
+	}
 }
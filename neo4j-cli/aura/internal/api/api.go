@@ -12,6 +12,8 @@ import (
 	"net/url"
 
 	"github.com/neo4j/cli/common/clicfg"
+	"github.com/neo4j/cli/common/clicfg/credentials"
+	"github.com/neo4j/cli/common/clierr"
 )
 
 const userAgent = "Neo4jCLI/%s"
@@ -33,6 +35,8 @@ type RequestConfig struct {
 	Method      string
 	PostBody    map[string]any
 	QueryParams map[string]string
+	// Credential to authenticate with, the current credential is used when not set
+	Credential *credentials.AuraCredential
 }
 
 func MakeRequest(cfg *clicfg.Config, path string, config *RequestConfig) (responseBody []byte, statusCode int, err error) {
@@ -63,9 +67,12 @@ func MakeRequest(cfg *clicfg.Config, path string, config *RequestConfig) (respon
 		panic(err)
 	}
 
-	credential, err := cfg.Credentials.Aura.GetDefault()
-	if err != nil {
-		return responseBody, 0, err
+	credential := config.Credential
+	if credential == nil {
+		credential, err = cfg.Credentials.Aura.GetCurrent()
+		if err != nil {
+			return responseBody, 0, err
+		}
 	}
 
 	req.Header, err = getHeaders(credential, cfg)
@@ -75,7 +82,7 @@ func MakeRequest(cfg *clicfg.Config, path string, config *RequestConfig) (respon
 
 	res, err := client.Do(req)
 	if err != nil {
-		panic(err)
+		return responseBody, 0, clierr.NewUpstreamError("cannot reach %s: %w", baseUrl, err)
 	}
 
 	defer res.Body.Close()
@@ -136,6 +143,11 @@ func addQueryParams(u *url.URL, params map[string]string) {
 	}
 }
 
+// Returns the User-Agent header the CLI sends, also for requests to instances
+func UserAgent(cfg *clicfg.Config) string {
+	return fmt.Sprintf(userAgent, cfg.Version)
+}
+
 // Checks status code is 2xx
 func IsSuccessful(statusCode int) bool {
 	return statusCode >= 200 && statusCode <= 299
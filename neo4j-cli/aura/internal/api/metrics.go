@@ -0,0 +1,51 @@
+// Copyright (c) "Neo4j"
+// Neo4j Sweden AB [http://neo4j.com]
+
+package api
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/neo4j/cli/common/clicfg"
+	"github.com/neo4j/cli/common/clicfg/credentials"
+	"github.com/neo4j/cli/common/clierr"
+)
+
+// Requests the metrics of a metrics integration endpoint in the Prometheus text exposition format.
+// The endpoint is authenticated with the access token of the credential, the current credential is used when none is given.
+func GetMetrics(cfg *clicfg.Config, endpointUrl string, credential *credentials.AuraCredential) ([]byte, error) {
+	if credential == nil {
+		var err error
+		credential, err = cfg.Credentials.Aura.GetCurrent()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	req, err := http.NewRequest(http.MethodGet, endpointUrl, nil)
+	if err != nil {
+		return nil, clierr.NewUsageError("invalid metrics integration endpoint %s: %s", endpointUrl, err)
+	}
+	req.Header, err = getHeaders(credential, cfg)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "text/plain")
+
+	client := http.Client{}
+	res, err := client.Do(req)
+	if err != nil {
+		return nil, clierr.NewUpstreamError("cannot reach %s: %w", endpointUrl, err)
+	}
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+	if !IsSuccessful(res.StatusCode) {
+		return nil, clierr.NewUpstreamError("cannot get metrics from %s: %s", endpointUrl, res.Status)
+	}
+	return body, nil
+}
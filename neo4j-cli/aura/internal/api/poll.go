@@ -28,6 +28,57 @@ func PollInstance(cfg *clicfg.Config, instanceId string, waitingStatus string) (
 	})
 }
 
+// Polls the instance until its update is done. As the instance can still be running before the update has started, running is only accepted once the instance has been seen updating or reports the updated values it returns.
+func PollInstanceUpdated(cfg *clicfg.Config, instanceId string, updated map[string]any) (*PollResponse, error) {
+	path := fmt.Sprintf("/instances/%s", instanceId)
+	seenUpdating := false
+	return poll(cfg, path, false, func(response *PollResponse, resBody []byte) bool {
+		if response.Data.Status == InstanceStatusUpdating {
+			seenUpdating = true
+			return false
+		}
+		if seenUpdating {
+			return true
+		}
+
+		var instance struct {
+			Data map[string]any
+		}
+		if err := json.Unmarshal(resBody, &instance); err != nil {
+			return false
+		}
+		for key, value := range updated {
+			if got, ok := instance.Data[key]; ok && fmt.Sprint(got) != fmt.Sprint(value) {
+				return false
+			}
+		}
+		return true
+	})
+}
+
+// Polls the instance until it can no longer be found, which is when its deletion has completed
+func PollInstanceDeleted(cfg *clicfg.Config, instanceId string) error {
+	path := fmt.Sprintf("/instances/%s", instanceId)
+	pollingConfig := cfg.Aura.PollingConfig()
+	for i := 0; i < pollingConfig.MaxRetries; i++ {
+		// The first check is immediate, an instance that can no longer be found is deleted whether or not the deletion has just started
+		if i > 0 {
+			time.Sleep(time.Second * time.Duration(pollingConfig.Interval))
+		}
+		_, statusCode, err := MakeRequest(cfg, path, &RequestConfig{
+			Method: http.MethodGet,
+		})
+		if statusCode == http.StatusNotFound {
+			return nil
+		}
+		if err != nil {
+			return clierr.NewUpstreamError("error polling: %w", err)
+		}
+	}
+
+	return clierr.NewUpstreamError("hit max retries [%d] polling", pollingConfig.MaxRetries)
+}
+
 func PollSnapshot(cfg *clicfg.Config, instanceId string, snapshotId string) (*PollResponse, error) {
 	path := fmt.Sprintf("/instances/%s/snapshots/%s", instanceId, snapshotId)
 	return Poll(cfg, path, func(status string) bool {
@@ -57,9 +108,25 @@ func PollGraphAnalyticsSessionReady(cfg *clicfg.Config, sessionId string, waitin
 }
 
 func Poll(cfg *clicfg.Config, url string, cond func(status string) bool) (*PollResponse, error) {
+	return poll(cfg, url, false, func(response *PollResponse, resBody []byte) bool {
+		return cond(response.Data.Status)
+	})
+}
+
+// Polls like Poll but checks the status once before the first interval, only for waiting on a state that is not the result of an operation just started, as that may not be reported yet
+func PollNow(cfg *clicfg.Config, url string, cond func(status string) bool) (*PollResponse, error) {
+	return poll(cfg, url, true, func(response *PollResponse, resBody []byte) bool {
+		return cond(response.Data.Status)
+	})
+}
+
+// Polls the URL until cond, which is also given the full response body, returns true. Sleeps an interval before every check unless immediate is set, in which case the first check is done straight away.
+func poll(cfg *clicfg.Config, url string, immediate bool, cond func(response *PollResponse, resBody []byte) bool) (*PollResponse, error) {
 	pollingConfig := cfg.Aura.PollingConfig()
 	for i := 0; i < pollingConfig.MaxRetries; i++ {
-		time.Sleep(time.Second * time.Duration(pollingConfig.Interval))
+		if i > 0 || !immediate {
+			time.Sleep(time.Second * time.Duration(pollingConfig.Interval))
+		}
 		resBody, statusCode, err := MakeRequest(cfg, url, &RequestConfig{
 			Method: http.MethodGet,
 		})
@@ -74,7 +141,7 @@ func Poll(cfg *clicfg.Config, url string, cond func(status string) bool) (*PollR
 			}
 
 			// Successful poll, return last response
-			if cond(response.Data.Status) {
+			if cond(&response, resBody) {
 				return &response, nil
 			}
 		}
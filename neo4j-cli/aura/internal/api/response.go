@@ -7,6 +7,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"mime"
 	"net/http"
 	"os"
 	"strings"
@@ -79,7 +80,11 @@ func handleResponseError(res *http.Response, credential *credentials.AuraCredent
 
 		err = json.Unmarshal(resBody, &errorResponse)
 		if err != nil {
-			panic(clierr.NewFatalError("unexpected error [status %d] running CLI with args %s, please report an issue in https://github.com/neo4j/cli", statusCode, os.Args[1:]))
+			// The Aura API never responds with a web page, which means the base URL points at another server
+			if isWebPage(res) {
+				return clierr.NewUpstreamError("%s was not found, please check the configured base URL", res.Request.URL)
+			}
+			return clierr.NewUpstreamError("unexpected error [status %d] running CLI with args %s, please report an issue in https://github.com/neo4j/cli", statusCode, os.Args[1:])
 		}
 
 		messages := []string{}
@@ -141,6 +146,11 @@ func handleResponseError(res *http.Response, credential *credentials.AuraCredent
 	}
 }
 
+func isWebPage(res *http.Response) bool {
+	mediaType, _, err := mime.ParseMediaType(res.Header.Get("Content-Type"))
+	return err == nil && mediaType == "text/html"
+}
+
 func getHeaders(credential *credentials.AuraCredential, cfg *clicfg.Config) (http.Header, error) {
 	token, err := getToken(credential, cfg)
 
@@ -148,12 +158,10 @@ func getHeaders(credential *credentials.AuraCredential, cfg *clicfg.Config) (htt
 		return nil, err
 	}
 
-	version := cfg.Version
-
 	return http.Header{
 		"Content-Type":  {"application/json"},
 		"Authorization": {fmt.Sprintf("Bearer %s", token)},
-		"User-Agent":    {fmt.Sprintf(userAgent, version)},
+		"User-Agent":    {UserAgent(cfg)},
 	}, nil
 }
 
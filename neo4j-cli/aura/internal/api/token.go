@@ -5,22 +5,59 @@ package api
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
 	"net/url"
 	"strings"
+	"sync"
 
 	"github.com/neo4j/cli/common/clicfg"
 	"github.com/neo4j/cli/common/clicfg/credentials"
 	"github.com/neo4j/cli/common/clierr"
 )
 
+// Error returned when an access token could not be obtained from the auth URL. StatusCode is 0 when the auth URL could not be reached
+type TokenRequestError struct {
+	StatusCode int
+	Err        error
+}
+
+func (e *TokenRequestError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *TokenRequestError) Unwrap() error {
+	return e.Err
+}
+
+// Concurrent requests share the access token of a credential, which must be requested and stored in the credentials file by one of them at a time
+var tokenMu sync.Mutex
+
 func getToken(credential *credentials.AuraCredential, cfg *clicfg.Config) (string, error) {
+	tokenMu.Lock()
+	defer tokenMu.Unlock()
+
 	if credential.HasValidAccessToken() {
 		return credential.AccessToken, nil
 	}
 
+	grant, err := RequestToken(cfg, credential)
+	if err != nil {
+		var tokenErr *TokenRequestError
+		if errors.As(err, &tokenErr) && tokenErr.StatusCode == http.StatusUnauthorized {
+			return "", clierr.NewUsageError("the provided credentials are invalid, expired, or revoked")
+		}
+		return "", clierr.NewUpstreamError("can't retrieve authentication token: %w", err)
+	}
+
+	cfg.Credentials.Aura.UpdateAccessToken(credential, grant.AccessToken, grant.ExpiresIn)
+	return grant.AccessToken, nil
+}
+
+// Requests a new access token for the credential from the configured auth URL, ignoring any cached access token
+func RequestToken(cfg *clicfg.Config, credential *credentials.AuraCredential) (*Grant, error) {
 	data := url.Values{}
 
 	data.Set("grant_type", "client_credentials")
@@ -29,14 +66,12 @@ func getToken(credential *credentials.AuraCredential, cfg *clicfg.Config) (strin
 
 	req, err := http.NewRequest(http.MethodPost, url, strings.NewReader(data.Encode()))
 	if err != nil {
-		panic(clierr.NewFatalError("can't retrieve authentication token. %w", err))
+		return nil, &TokenRequestError{Err: err}
 	}
 
-	version := cfg.Version
-
 	req.Header = http.Header{
 		"Content-Type": {"application/x-www-form-urlencoded"},
-		"User-Agent":   {fmt.Sprintf(userAgent, version)},
+		"User-Agent":   {UserAgent(cfg)},
 	}
 	req.SetBasicAuth(credential.ClientId, credential.ClientSecret)
 
@@ -44,31 +79,25 @@ func getToken(credential *credentials.AuraCredential, cfg *clicfg.Config) (strin
 
 	res, err := client.Do(req)
 	if err != nil {
-		panic(clierr.NewFatalError("can't retrieve authentication token. %w", err))
+		return nil, &TokenRequestError{Err: err}
 	}
 	defer res.Body.Close()
 
-	switch statusCode := res.StatusCode; statusCode {
-	case http.StatusUnauthorized:
-		return "", clierr.NewUsageError("the provided credentials are invalid, expired, or revoked")
-	case http.StatusBadRequest:
-	case http.StatusForbidden:
-	case http.StatusNotFound:
-		panic(clierr.NewFatalError("can't retrieve authentication token. Response status code [%d]", http.StatusBadRequest))
+	if !IsSuccessful(res.StatusCode) {
+		return nil, &TokenRequestError{StatusCode: res.StatusCode, Err: fmt.Errorf("response status code [%d]", res.StatusCode)}
 	}
 
 	resBody, err := io.ReadAll(res.Body)
 	if err != nil {
-		panic(clierr.NewFatalError("can't retrieve authentication token. %w", err))
+		return nil, &TokenRequestError{StatusCode: res.StatusCode, Err: err}
 	}
 
 	var grant Grant
 
 	err = json.Unmarshal(resBody, &grant)
-	if err != nil {
-		panic(clierr.NewFatalError("can't retrieve authentication token. %w", err))
+	if err != nil || grant.AccessToken == "" {
+		return nil, &TokenRequestError{StatusCode: res.StatusCode, Err: fmt.Errorf("response is not an access token grant")}
 	}
 
-	cfg.Credentials.Aura.UpdateAccessToken(credential, grant.AccessToken, grant.ExpiresIn)
-	return grant.AccessToken, err
+	return &grant, nil
 }
@@ -0,0 +1,29 @@
+// Copyright (c) "Neo4j"
+// Neo4j Sweden AB [http://neo4j.com]
+
+package flags
+
+import "errors"
+
+type CdcEnrichmentMode string
+
+// String is used both by fmt.Print and by Cobra in help text
+func (e *CdcEnrichmentMode) String() string {
+	return string(*e)
+}
+
+// Set must have pointer receiver so it doesn't change the value of a copy
+func (e *CdcEnrichmentMode) Set(v string) error {
+	switch v {
+	case "OFF", "DIFF", "FULL":
+		*e = CdcEnrichmentMode(v)
+		return nil
+	default:
+		return errors.New(`must be one of "OFF", "DIFF", or "FULL"`)
+	}
+}
+
+// Type is only used in help text
+func (e *CdcEnrichmentMode) Type() string {
+	return "mode"
+}
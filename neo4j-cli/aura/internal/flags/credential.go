@@ -0,0 +1,34 @@
+// Copyright (c) "Neo4j"
+// Neo4j Sweden AB [http://neo4j.com]
+
+package flags
+
+import "github.com/neo4j/cli/common/clicfg/credentials"
+
+// Credential selects the credential used for a single invocation without changing the default credential
+type Credential struct {
+	credentials *credentials.AuraCredentials
+}
+
+func NewCredential(credentials *credentials.AuraCredentials) *Credential {
+	return &Credential{credentials: credentials}
+}
+
+// String is used both by fmt.Print and by Cobra in help text
+func (e *Credential) String() string {
+	return e.credentials.Override()
+}
+
+// Set validates that the credential exists before using it for the invocation
+func (e *Credential) Set(v string) error {
+	if _, err := e.credentials.Get(v); err != nil {
+		return err
+	}
+	e.credentials.SetOverride(v)
+	return nil
+}
+
+// Type is only used in help text
+func (e *Credential) Type() string {
+	return "name"
+}
@@ -0,0 +1,29 @@
+// Copyright (c) "Neo4j"
+// Neo4j Sweden AB [http://neo4j.com]
+
+package flags
+
+import "errors"
+
+type EnvFormat string
+
+// String is used both by fmt.Print and by Cobra in help text
+func (e *EnvFormat) String() string {
+	return string(*e)
+}
+
+// Set must have pointer receiver so it doesn't change the value of a copy
+func (e *EnvFormat) Set(v string) error {
+	switch v {
+	case "shell", "dotenv", "json", "k8s":
+		*e = EnvFormat(v)
+		return nil
+	default:
+		return errors.New(`must be one of "shell", "dotenv", "json", or "k8s"`)
+	}
+}
+
+// Type is only used in help text
+func (e *EnvFormat) Type() string {
+	return "format"
+}
@@ -0,0 +1,29 @@
+// Copyright (c) "Neo4j"
+// Neo4j Sweden AB [http://neo4j.com]
+
+package flags
+
+import "errors"
+
+type LogFormat string
+
+// String is used both by fmt.Print and by Cobra in help text
+func (l *LogFormat) String() string {
+	return string(*l)
+}
+
+// Set must have pointer receiver so it doesn't change the value of a copy
+func (l *LogFormat) Set(v string) error {
+	switch v {
+	case "text", "json":
+		*l = LogFormat(v)
+		return nil
+	default:
+		return errors.New(`must be one of "text" or "json"`)
+	}
+}
+
+// Type is only used in help text
+func (l *LogFormat) Type() string {
+	return "format"
+}
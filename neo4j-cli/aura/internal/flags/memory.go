@@ -3,10 +3,20 @@
 
 package flags
 
-import "errors"
+import (
+	"errors"
+	"regexp"
+	"slices"
+)
 
 type Memory string
 
+// Only the format is checked, the sizes available for an instance depend on the instance configurations of its tenant
+var memoryPattern = regexp.MustCompile(`^[1-9][0-9]*GB$`)
+
+// The memory sizes of Aura instances, checked instead of the instance configurations of the tenant when those are not retrieved
+var knownMemorySizes = []string{"1GB", "2GB", "4GB", "8GB", "16GB", "24GB", "32GB", "48GB", "64GB", "128GB", "192GB", "256GB", "384GB", "512GB"}
+
 // String is used both by fmt.Print and by Cobra in help text
 func (e *Memory) String() string {
 	return string(*e)
@@ -14,16 +24,22 @@ func (e *Memory) String() string {
 
 // Set must have pointer receiver so it doesn't change the value of a copy
 func (e *Memory) Set(v string) error {
-	switch v {
-	case "1GB", "2GB", "4GB", "8GB", "16GB", "24GB", "32GB", "48GB", "64GB", "128GB", "192GB", "256GB", "384GB", "512GB":
-		*e = Memory(v)
-		return nil
-	default:
-		return errors.New(`must be one of "1GB", "2GB", "4GB", "8GB", "16GB", "24GB", "32GB", "48GB", "64GB", "128GB", "192GB", "256GB", "384GB", or "512GB"`)
+	if !memoryPattern.MatchString(v) {
+		return errors.New(`must be a size in GB such as "8GB"`)
 	}
+	*e = Memory(v)
+	return nil
 }
 
 // Type is only used in help text
 func (e *Memory) Type() string {
 	return "memory"
 }
+
+// Returns an error when the memory is not one of the known memory sizes of Aura instances
+func CheckKnownMemory(v string) error {
+	if !slices.Contains(knownMemorySizes, v) {
+		return errors.New(`must be one of "1GB", "2GB", "4GB", "8GB", "16GB", "24GB", "32GB", "48GB", "64GB", "128GB", "192GB", "256GB", "384GB", or "512GB"`)
+	}
+	return nil
+}
@@ -0,0 +1,29 @@
+// Copyright (c) "Neo4j"
+// Neo4j Sweden AB [http://neo4j.com]
+
+package flags
+
+import "errors"
+
+type ReportFormat string
+
+// String is used both by fmt.Print and by Cobra in help text
+func (r *ReportFormat) String() string {
+	return string(*r)
+}
+
+// Set must have pointer receiver so it doesn't change the value of a copy
+func (r *ReportFormat) Set(v string) error {
+	switch v {
+	case "json", "csv", "markdown":
+		*r = ReportFormat(v)
+		return nil
+	default:
+		return errors.New(`must be one of "json", "csv", or "markdown"`)
+	}
+}
+
+// Type is only used in help text
+func (r *ReportFormat) Type() string {
+	return "format"
+}
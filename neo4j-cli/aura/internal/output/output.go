@@ -4,6 +4,7 @@
 package output
 
 import (
+	"encoding/csv"
 	"encoding/json"
 	"fmt"
 	"reflect"
@@ -28,6 +29,8 @@ func PrintBodyMap(cmd *cobra.Command, cfg *clicfg.Config, values api.ResponseDat
 		cmd.Println(string(bytes))
 	case "table", "default":
 		printTable(cmd, values, fields)
+	case "csv":
+		printCsv(cmd, values, fields)
 	default:
 		// This is in case the value is unknown
 		cmd.Println(values)
@@ -50,7 +53,7 @@ func getNestedField(v map[string]any, subFields []string) string {
 		if value == nil {
 			return ""
 		}
-		if reflect.TypeOf(value).Kind() == reflect.Slice {
+		if kind := reflect.TypeOf(value).Kind(); kind == reflect.Slice || kind == reflect.Map {
 			marshaledSlice, _ := json.MarshalIndent(value, "", "  ")
 			return string(marshaledSlice)
 		}
@@ -88,3 +91,27 @@ func printTable(cmd *cobra.Command, responseData api.ResponseData, fields []stri
 	t.SetStyle(table.StyleLight)
 	cmd.Println(t.Render())
 }
+
+func printCsv(cmd *cobra.Command, responseData api.ResponseData, fields []string) {
+	var b strings.Builder
+	w := csv.NewWriter(&b)
+
+	if err := w.Write(fields); err != nil {
+		panic(err)
+	}
+	for _, v := range responseData.AsArray() {
+		row := []string{}
+		for _, f := range fields {
+			row = append(row, getNestedField(v, strings.Split(f, ":")))
+		}
+		if err := w.Write(row); err != nil {
+			panic(err)
+		}
+	}
+
+	w.Flush()
+	if err := w.Error(); err != nil {
+		panic(err)
+	}
+	cmd.Print(b.String())
+}
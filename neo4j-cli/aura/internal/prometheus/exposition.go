@@ -0,0 +1,118 @@
+// Copyright (c) "Neo4j"
+// Neo4j Sweden AB [http://neo4j.com]
+
+package prometheus
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// A sample of a metric in the Prometheus text exposition format
+type Sample struct {
+	Name   string
+	Labels map[string]string
+	Value  float64
+}
+
+// Parses metrics in the Prometheus text exposition format. Comments, including HELP and TYPE lines, and timestamps are ignored.
+func Parse(data []byte) ([]Sample, error) {
+	samples := []Sample{}
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for lineNumber := 1; scanner.Scan(); lineNumber++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		sample, err := parseSample(line)
+		if err != nil {
+			return nil, fmt.Errorf("invalid metrics on line %d: %w", lineNumber, err)
+		}
+		samples = append(samples, sample)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return samples, nil
+}
+
+// Parses a line such as: name{label="value",other="value"} 1.5 1712345678000
+func parseSample(line string) (Sample, error) {
+	sample := Sample{Labels: map[string]string{}}
+
+	end := strings.IndexAny(line, "{ \t")
+	if end <= 0 {
+		return sample, fmt.Errorf("missing value in %q", line)
+	}
+	sample.Name = line[:end]
+	rest := line[end:]
+
+	if strings.HasPrefix(rest, "{") {
+		var err error
+		rest, err = parseLabels(rest[1:], sample.Labels)
+		if err != nil {
+			return sample, err
+		}
+	}
+
+	fields := strings.Fields(rest)
+	if len(fields) == 0 || len(fields) > 2 {
+		return sample, fmt.Errorf("invalid value in %q", line)
+	}
+	value, err := strconv.ParseFloat(fields[0], 64)
+	if err != nil {
+		return sample, fmt.Errorf("invalid value %q of metric %s", fields[0], sample.Name)
+	}
+	sample.Value = value
+	return sample, nil
+}
+
+// Parses the labels up to the closing brace into labels and returns what follows the brace
+func parseLabels(rest string, labels map[string]string) (string, error) {
+	for {
+		rest = strings.TrimLeft(rest, " \t")
+		if strings.HasPrefix(rest, "}") {
+			return rest[1:], nil
+		}
+
+		equals := strings.Index(rest, "=")
+		if equals <= 0 || len(rest) < equals+2 || rest[equals+1] != '"' {
+			return "", fmt.Errorf("invalid label in %q", rest)
+		}
+		name := strings.TrimSpace(rest[:equals])
+		rest = rest[equals+2:]
+
+		var value strings.Builder
+		closed := false
+		for i := 0; i < len(rest); i++ {
+			c := rest[i]
+			if c == '\\' && i+1 < len(rest) {
+				i++
+				switch rest[i] {
+				case 'n':
+					value.WriteByte('\n')
+				default:
+					value.WriteByte(rest[i])
+				}
+				continue
+			}
+			if c == '"' {
+				rest = rest[i+1:]
+				closed = true
+				break
+			}
+			value.WriteByte(c)
+		}
+		if !closed {
+			return "", fmt.Errorf("unterminated value of label %s", name)
+		}
+		labels[name] = value.String()
+
+		rest = strings.TrimLeft(rest, " \t")
+		rest = strings.TrimPrefix(rest, ",")
+	}
+}
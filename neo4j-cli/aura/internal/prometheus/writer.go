@@ -0,0 +1,55 @@
+// Copyright (c) "Neo4j"
+// Neo4j Sweden AB [http://neo4j.com]
+
+package prometheus
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// A gauge with its samples, which all have the name of the gauge
+type Gauge struct {
+	Name    string
+	Help    string
+	Samples []Sample
+}
+
+func NewGauge(name string, help string) *Gauge {
+	return &Gauge{Name: name, Help: help, Samples: []Sample{}}
+}
+
+func (g *Gauge) Add(value float64, labels map[string]string) {
+	g.Samples = append(g.Samples, Sample{Name: g.Name, Labels: labels, Value: value})
+}
+
+// Writes the gauges in the Prometheus text exposition format, with the labels of each sample sorted by name
+func Write(w io.Writer, gauges []*Gauge) error {
+	b := bufio.NewWriter(w)
+	for _, gauge := range gauges {
+		fmt.Fprintf(b, "# HELP %s %s\n", gauge.Name, helpEscaper.Replace(gauge.Help))
+		fmt.Fprintf(b, "# TYPE %s gauge\n", gauge.Name)
+		for _, sample := range gauge.Samples {
+			b.WriteString(sample.Name)
+			if len(sample.Labels) > 0 {
+				labels := []string{}
+				for _, name := range slices.Sorted(maps.Keys(sample.Labels)) {
+					labels = append(labels, fmt.Sprintf(`%s="%s"`, name, labelEscaper.Replace(sample.Labels[name])))
+				}
+				fmt.Fprintf(b, "{%s}", strings.Join(labels, ","))
+			}
+			fmt.Fprintf(b, " %s\n", strconv.FormatFloat(sample.Value, 'f', -1, 64))
+		}
+	}
+	return b.Flush()
+}
+
+var (
+	helpEscaper  = strings.NewReplacer(`\`, `\\`, "\n", `\n`)
+	labelEscaper = strings.NewReplacer(`\`, `\\`, "\n", `\n`, `"`, `\"`)
+)
@@ -0,0 +1,239 @@
+// Copyright (c) "Neo4j"
+// Neo4j Sweden AB [http://neo4j.com]
+
+package apply
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/neo4j/cli/common/clicfg"
+	"github.com/neo4j/cli/common/clierr"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/api"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/output"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/subcommands/utils"
+	"github.com/spf13/cobra"
+)
+
+func NewCmd(cfg *clicfg.Config) *cobra.Command {
+	var (
+		file         string
+		prune        bool
+		await        bool
+		confirmation utils.ConfirmationFlags
+	)
+
+	const (
+		fileFlag  = "file"
+		pruneFlag = "prune"
+		awaitFlag = "await"
+	)
+
+	cmd := &cobra.Command{
+		Use:   "apply",
+		Short: "Creates, resizes and renames instances to match a YAML file",
+		Long: `Reads the desired instances from a YAML file, compares them to the existing instances of the tenants used in the file and creates, resizes or renames instances to match. Use the plan command to see the changes without applying them.
+
+The file lists the desired instances under an instances key:
+
+  instances:
+    - name: production
+      tenant_id: YOUR_TENANT_ID
+      type: enterprise-db
+      cloud_provider: gcp
+      region: europe-west1
+      memory: 8GB
+      version: "5"
+      customer_managed_key_id: YOUR_CMK_ID
+      vector_optimized: false
+      graph_analytics_plugin: false
+
+Instances are matched by name within their tenant, set the id of an instance to match it by ID instead, which allows renaming it. The tenant defaults to the configured default tenant. The type, version, cloud provider, region and customer managed key of an existing instance cannot be changed, and nothing is applied while the file asks for such a change.
+
+Existing instances of the tenants used in the file that are not listed in the file are only deleted with --prune. When run in a terminal the deletions have to be confirmed first, unless --yes is set. Nothing is applied while one of the instances to delete has an ID or name matching the protected-resources config value, unless --force is set.
+
+The initial credentials of created instances are returned, it is important to store them until you have the chance to login to your running instances and change them.`,
+		Args:    cobra.NoArgs,
+		PreRunE: bindFlags(cfg),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			manifest, err := ReadManifest(cfg, file)
+			if err != nil {
+				return err
+			}
+
+			cmd.SilenceUsage = true
+			plan, err := ComputePlan(cfg, manifest, prune)
+			if err != nil {
+				return err
+			}
+			if len(plan.Conflicts) > 0 {
+				return conflictsError(plan)
+			}
+			if err := confirmDeletions(cmd, cfg, &confirmation, plan); err != nil {
+				return err
+			}
+
+			results := []map[string]any{}
+			pending := map[string]*Change{}
+			var applyErr error
+			for _, change := range plan.Changes {
+				if change.Action == ActionNoOp {
+					continue
+				}
+
+				result, err := applyChange(cfg, change)
+				if err != nil {
+					applyErr = clierr.NewUpstreamError("cannot %s instance %s: %w", change.Action, change.Name, err)
+					break
+				}
+				results = append(results, result)
+
+				switch change.Action {
+				case ActionCreate:
+					pending[fmt.Sprint(result["id"])] = change
+				case ActionUpdate:
+					pending[change.Id] = change
+				}
+			}
+
+			output.PrintBodyMap(cmd, cfg, api.NewListResponseData(results), []string{"action", "id", "name", "tenant_id", "connection_url", "username", "password"})
+			if applyErr != nil {
+				return applyErr
+			}
+
+			if await && len(pending) > 0 {
+				cmd.Println("Waiting for instances to be ready...")
+				for _, result := range results {
+					id := fmt.Sprint(result["id"])
+					change, ok := pending[id]
+					if !ok {
+						continue
+					}
+
+					var pollResponse *api.PollResponse
+					var err error
+					if change.Action == ActionUpdate {
+						pollResponse, err = api.PollInstanceUpdated(cfg, id, change.body)
+					} else {
+						pollResponse, err = api.PollInstance(cfg, id, api.InstanceStatusCreating)
+					}
+					if err != nil {
+						return err
+					}
+
+					cmd.Printf("Instance %s status: %s\n", result["name"], pollResponse.Data.Status)
+				}
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&file, fileFlag, "f", "", "(required) Path to the YAML file listing the desired instances")
+	cmd.MarkFlagRequired(fileFlag)
+
+	cmd.Flags().BoolVar(&prune, pruneFlag, false, "Deletes the existing instances of the tenants used in the file that are not listed in the file")
+
+	cmd.Flags().BoolVar(&await, awaitFlag, false, "Waits until created and updated instances are ready")
+
+	utils.AddConfirmationFlags(cmd, &confirmation)
+
+	addConnectionFlags(cmd)
+
+	return cmd
+}
+
+// Sends the create, update or delete request for the change and returns a summary of the result
+func applyChange(cfg *clicfg.Config, change *Change) (map[string]any, error) {
+	result := map[string]any{
+		"action":         change.Action,
+		"id":             change.Id,
+		"name":           change.Name,
+		"tenant_id":      change.TenantId,
+		"connection_url": "",
+		"username":       "",
+		"password":       "",
+	}
+
+	switch change.Action {
+	case ActionCreate:
+		resBody, _, err := api.MakeRequest(cfg, "/instances", &api.RequestConfig{
+			Method:   http.MethodPost,
+			PostBody: change.body,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		var response api.CreateInstanceResponse
+		if err := json.Unmarshal(resBody, &response); err != nil {
+			return nil, err
+		}
+		result["id"] = response.Data.Id
+		result["connection_url"] = response.Data.ConnectionUrl
+		result["username"] = response.Data.Username
+		result["password"] = response.Data.Password
+	case ActionUpdate:
+		if _, _, err := api.MakeRequest(cfg, fmt.Sprintf("/instances/%s", change.Id), &api.RequestConfig{
+			Method:   http.MethodPatch,
+			PostBody: change.body,
+		}); err != nil {
+			return nil, err
+		}
+	case ActionDelete:
+		if _, _, err := api.MakeRequest(cfg, fmt.Sprintf("/instances/%s", change.Id), &api.RequestConfig{
+			Method: http.MethodDelete,
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+// Refuses the plan when one of the instances it deletes is protected, then asks for confirmation of the deletions
+func confirmDeletions(cmd *cobra.Command, cfg *clicfg.Config, confirmation *utils.ConfirmationFlags, plan *Plan) error {
+	instances := []string{}
+	for _, change := range plan.Changes {
+		if change.Action != ActionDelete {
+			continue
+		}
+		if err := confirmation.CheckProtected(cfg, "instance", change.Id, change.Name, "delete"); err != nil {
+			return err
+		}
+		instances = append(instances, fmt.Sprintf("%s (%s)", change.Name, change.Id))
+	}
+	if len(instances) == 0 {
+		return nil
+	}
+
+	return confirmation.Ask(cmd, fmt.Sprintf("Delete %d instances: %s?", len(instances), strings.Join(instances, ", ")))
+}
+
+func conflictsError(plan *Plan) error {
+	return clierr.NewUsageError("the file cannot be applied:\n  %s", strings.Join(plan.Conflicts, "\n  "))
+}
+
+func addConnectionFlags(cmd *cobra.Command) {
+	cmd.Flags().String("auth-url", "", "")
+	cmd.Flags().String("base-url", "", "")
+	cmd.Flags().String("output", "", fmt.Sprintf("Format to print console output in, from a choice of [%s]", strings.Join(clicfg.ValidOutputValues[:], ", ")))
+}
+
+func bindFlags(cfg *clicfg.Config) func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		cfg.Aura.BindBaseUrl(cmd.Flags().Lookup("base-url"))
+		cfg.Aura.BindAuthUrl(cmd.Flags().Lookup("auth-url"))
+
+		if outputValue := cmd.Flags().Lookup("output").Value.String(); outputValue != "" {
+			if _, err := cfg.Aura.ParseValue("output", outputValue); err != nil {
+				return err
+			}
+		}
+		cfg.Aura.BindOutput(cmd.Flags().Lookup("output"))
+
+		return nil
+	}
+}
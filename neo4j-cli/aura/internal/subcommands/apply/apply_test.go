@@ -0,0 +1,264 @@
+// Copyright (c) "Neo4j"
+// Neo4j Sweden AB [http://neo4j.com]
+
+package apply_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/test/testutils"
+	"github.com/stretchr/testify/assert"
+)
+
+const createResponse = `{
+	"data": {
+		"id": "stag1",
+		"connection_url": "YOUR_CONNECTION_URL",
+		"username": "neo4j",
+		"password": "letMeIn123!",
+		"tenant_id": "tenant1",
+		"cloud_provider": "gcp",
+		"region": "europe-west1",
+		"type": "professional-db",
+		"name": "staging"
+	}
+}`
+
+func TestApply(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	mockInstances(&helper)
+	createMock := helper.NewRequestHandlerMock("POST /v1/instances", http.StatusAccepted, createResponse)
+	updateMock := helper.NewRequestHandlerMock("PATCH /v1/instances/prod1", http.StatusAccepted, `{"data": {"id": "prod1"}}`)
+	deleteMock := helper.NewRequestHandlerMock("DELETE /v1/instances/old1", http.StatusAccepted, `{"data": {"id": "old1"}}`)
+	helper.SetFile("instances.yaml", manifest)
+
+	helper.ExecuteCommand("apply -f instances.yaml")
+
+	helper.AssertErr("")
+	createMock.AssertCalledTimes(1)
+	createMock.AssertCalledWithBody(`{
+		"cloud_provider": "gcp",
+		"graph_analytics_plugin": false,
+		"memory": "2GB",
+		"name": "staging",
+		"region": "europe-west1",
+		"tenant_id": "tenant1",
+		"type": "professional-db",
+		"vector_optimized": false,
+		"version": "5"
+	}`)
+	updateMock.AssertCalledTimes(1)
+	updateMock.AssertCalledWithBody(`{"memory": "16GB"}`)
+	deleteMock.AssertCalledTimes(0)
+	helper.AssertOutJson(`{
+		"data": [
+			{
+				"action": "update",
+				"connection_url": "",
+				"id": "prod1",
+				"name": "production",
+				"password": "",
+				"tenant_id": "tenant1",
+				"username": ""
+			},
+			{
+				"action": "create",
+				"connection_url": "YOUR_CONNECTION_URL",
+				"id": "stag1",
+				"name": "staging",
+				"password": "letMeIn123!",
+				"tenant_id": "tenant1",
+				"username": "neo4j"
+			}
+		]
+	}`)
+}
+
+func TestApplyWithPrune(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	mockInstances(&helper)
+	helper.NewRequestHandlerMock("POST /v1/instances", http.StatusAccepted, createResponse)
+	helper.NewRequestHandlerMock("PATCH /v1/instances/prod1", http.StatusAccepted, `{"data": {"id": "prod1"}}`)
+	deleteMock := helper.NewRequestHandlerMock("DELETE /v1/instances/old1", http.StatusAccepted, `{"data": {"id": "old1"}}`)
+	helper.SetFile("instances.yaml", manifest)
+
+	helper.ExecuteCommand("apply -f instances.yaml --prune")
+
+	helper.AssertErr("")
+	deleteMock.AssertCalledTimes(1)
+}
+
+func TestApplyWithPruneOfProtectedInstanceAppliesNothing(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.SetConfigValue("aura.protected-resources", []string{"^old$"})
+	mockInstances(&helper)
+	createMock := helper.NewRequestHandlerMock("POST /v1/instances", http.StatusAccepted, createResponse)
+	updateMock := helper.NewRequestHandlerMock("PATCH /v1/instances/prod1", http.StatusAccepted, `{"data": {"id": "prod1"}}`)
+	deleteMock := helper.NewRequestHandlerMock("DELETE /v1/instances/old1", http.StatusAccepted, `{"data": {"id": "old1"}}`)
+	helper.SetFile("instances.yaml", manifest)
+
+	helper.ExecuteCommand("apply -f instances.yaml --prune")
+
+	helper.AssertErr("Error: instance old1 is protected by the protected-resources config value, use --force to delete it")
+	createMock.AssertCalledTimes(0)
+	updateMock.AssertCalledTimes(0)
+	deleteMock.AssertCalledTimes(0)
+}
+
+func TestApplyWithPruneOfProtectedInstanceAndForce(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.SetConfigValue("aura.protected-resources", []string{"old1"})
+	mockInstances(&helper)
+	helper.NewRequestHandlerMock("POST /v1/instances", http.StatusAccepted, createResponse)
+	helper.NewRequestHandlerMock("PATCH /v1/instances/prod1", http.StatusAccepted, `{"data": {"id": "prod1"}}`)
+	deleteMock := helper.NewRequestHandlerMock("DELETE /v1/instances/old1", http.StatusAccepted, `{"data": {"id": "old1"}}`)
+	helper.SetFile("instances.yaml", manifest)
+
+	helper.ExecuteCommand("apply -f instances.yaml --prune --force")
+
+	helper.AssertErr("")
+	deleteMock.AssertCalledTimes(1)
+}
+
+func TestApplyWithPruneNotConfirmed(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	mockInstances(&helper)
+	createMock := helper.NewRequestHandlerMock("POST /v1/instances", http.StatusAccepted, createResponse)
+	deleteMock := helper.NewRequestHandlerMock("DELETE /v1/instances/old1", http.StatusAccepted, `{"data": {"id": "old1"}}`)
+	helper.SetFile("instances.yaml", manifest)
+	helper.SetTerminalInput("n\n")
+
+	helper.ExecuteCommand("apply -f instances.yaml --prune")
+
+	helper.AssertOut("Delete 1 instances: old (old1)? [y/N]:")
+	helper.AssertErr("Error: the operation was not confirmed")
+	createMock.AssertCalledTimes(0)
+	deleteMock.AssertCalledTimes(0)
+}
+
+func TestApplyWithRename(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	mockInstances(&helper)
+	updateMock := helper.NewRequestHandlerMock("PATCH /v1/instances/prod1", http.StatusAccepted, `{"data": {"id": "prod1"}}`)
+	helper.SetFile("instances.yaml", `
+instances:
+  - id: prod1
+    name: production-eu
+    tenant_id: tenant1
+    type: enterprise-db
+    cloud_provider: gcp
+    region: europe-west1
+    memory: 8GB
+`)
+
+	helper.ExecuteCommand("apply -f instances.yaml")
+
+	helper.AssertErr("")
+	updateMock.AssertCalledTimes(1)
+	updateMock.AssertCalledWithBody(`{"name": "production-eu"}`)
+}
+
+func TestApplyWithAwait(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.NewRequestHandlerMock("GET /v1/instances", http.StatusOK, `{"data": []}`)
+	helper.NewRequestHandlerMock("POST /v1/instances", http.StatusAccepted, createResponse)
+	pollMock := helper.NewRequestHandlerMock("GET /v1/instances/stag1", http.StatusOK, `{"data": {"id": "stag1", "status": "creating"}}`).
+		AddResponse(http.StatusOK, `{"data": {"id": "stag1", "status": "running"}}`)
+	helper.SetFile("instances.yaml", `
+instances:
+  - name: staging
+    tenant_id: tenant1
+    type: professional-db
+    cloud_provider: gcp
+    region: europe-west1
+    memory: 2GB
+`)
+
+	helper.ExecuteCommand("apply -f instances.yaml --await --output table")
+
+	helper.AssertErr("")
+	pollMock.AssertCalledTimes(2)
+	helper.AssertOut(`
+┌────────┬───────┬─────────┬───────────┬─────────────────────┬──────────┬─────────────┐
+│ ACTION │ ID    │ NAME    │ TENANT_ID │ CONNECTION_URL      │ USERNAME │ PASSWORD    │
+├────────┼───────┼─────────┼───────────┼─────────────────────┼──────────┼─────────────┤
+│ create │ stag1 │ staging │ tenant1   │ YOUR_CONNECTION_URL │ neo4j    │ letMeIn123! │
+└────────┴───────┴─────────┴───────────┴─────────────────────┴──────────┴─────────────┘
+Waiting for instances to be ready...
+Instance staging status: running
+	`)
+}
+
+func TestApplyWithAwaitBeforeUpdateStarts(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.NewRequestHandlerMock("GET /v1/instances", http.StatusOK, `{"data": [{"id": "prod1", "name": "production", "tenant_id": "tenant1", "cloud_provider": "gcp"}]}`)
+	instance := `{"data": {"id": "prod1", "name": "production", "tenant_id": "tenant1", "status": "running", "cloud_provider": "gcp", "region": "europe-west1", "type": "enterprise-db", "memory": "%s"}}`
+	// The instance is still running with its old memory when first polled
+	getMock := helper.NewRequestHandlerMock("GET /v1/instances/prod1", http.StatusOK, fmt.Sprintf(instance, "8GB")).
+		AddResponse(http.StatusOK, fmt.Sprintf(instance, "8GB")).
+		AddResponse(http.StatusOK, fmt.Sprintf(instance, "16GB"))
+	helper.NewRequestHandlerMock("PATCH /v1/instances/prod1", http.StatusAccepted, `{"data": {"id": "prod1"}}`)
+	helper.SetFile("instances.yaml", `
+instances:
+  - name: production
+    tenant_id: tenant1
+    type: enterprise-db
+    cloud_provider: gcp
+    region: europe-west1
+    memory: 16GB
+`)
+
+	helper.ExecuteCommand("apply -f instances.yaml --await")
+
+	helper.AssertErr("")
+	getMock.AssertCalledTimes(3)
+	assert.Contains(t, helper.PrintOut(), "Waiting for instances to be ready...\nInstance production status: running")
+}
+
+func TestApplyWithImmutableChangeAppliesNothing(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	mockInstances(&helper)
+	createMock := helper.NewRequestHandlerMock("POST /v1/instances", http.StatusAccepted, createResponse)
+	helper.SetFile("instances.yaml", `
+instances:
+  - name: production
+    tenant_id: tenant1
+    type: enterprise-db
+    cloud_provider: gcp
+    region: us-east1
+    memory: 8GB
+  - name: staging
+    tenant_id: tenant1
+    type: professional-db
+    cloud_provider: gcp
+    region: europe-west1
+    memory: 2GB
+`)
+
+	helper.ExecuteCommand("apply -f instances.yaml")
+
+	helper.AssertErr(`Error: the file cannot be applied:
+  region of instance production cannot be changed from "europe-west1" to "us-east1", the instance has to be recreated`)
+	helper.AssertOut("")
+	createMock.AssertCalledTimes(0)
+}
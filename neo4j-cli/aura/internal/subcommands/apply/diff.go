@@ -0,0 +1,247 @@
+// Copyright (c) "Neo4j"
+// Neo4j Sweden AB [http://neo4j.com]
+
+package apply
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/neo4j/cli/common/clicfg"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/api"
+)
+
+const (
+	ActionCreate = "create"
+	ActionUpdate = "update"
+	ActionDelete = "delete"
+	ActionNoOp   = "no-op"
+)
+
+type Change struct {
+	Action   string
+	Id       string
+	Name     string
+	TenantId string
+	// Human readable description of each difference between the existing and the desired instance
+	Details []string
+	// Body of the create or update request
+	body map[string]any
+}
+
+type Plan struct {
+	Changes []*Change
+	// Differences that cannot be applied to an existing instance, the plan cannot be applied while there are any
+	Conflicts []string
+}
+
+func (p *Plan) rows() []map[string]any {
+	rows := []map[string]any{}
+	for _, change := range p.Changes {
+		rows = append(rows, map[string]any{
+			"action":    change.Action,
+			"id":        change.Id,
+			"name":      change.Name,
+			"tenant_id": change.TenantId,
+			"changes":   strings.Join(change.Details, ", "),
+		})
+	}
+	return rows
+}
+
+// Compares the desired instances to the existing instances of the tenants used in the manifest. Existing instances that are not in the manifest are only deleted when prune is set.
+func ComputePlan(cfg *clicfg.Config, manifest *Manifest, prune bool) (*Plan, error) {
+	tenantIds := []string{}
+	existingByTenant := map[string][]map[string]any{}
+	for _, desired := range manifest.Instances {
+		if _, ok := existingByTenant[desired.TenantId]; ok {
+			continue
+		}
+		existing, err := listInstances(cfg, desired.TenantId)
+		if err != nil {
+			return nil, err
+		}
+		tenantIds = append(tenantIds, desired.TenantId)
+		existingByTenant[desired.TenantId] = existing
+	}
+
+	plan := &Plan{}
+	matched := map[string]bool{}
+	for _, desired := range manifest.Instances {
+		existing, err := matchInstance(desired, existingByTenant[desired.TenantId])
+		if err != nil {
+			plan.Conflicts = append(plan.Conflicts, err.Error())
+			continue
+		}
+
+		if existing == nil {
+			plan.Changes = append(plan.Changes, &Change{
+				Action:   ActionCreate,
+				Name:     desired.Name,
+				TenantId: desired.TenantId,
+				body:     desired.createBody(),
+			})
+			continue
+		}
+
+		id := fmt.Sprint(existing["id"])
+		matched[id] = true
+
+		instance, err := getInstance(cfg, id)
+		if err != nil {
+			return nil, err
+		}
+
+		change, conflicts := diffInstance(desired, instance)
+		change.Id = id
+		plan.Changes = append(plan.Changes, change)
+		plan.Conflicts = append(plan.Conflicts, conflicts...)
+	}
+
+	if prune {
+		for _, tenantId := range tenantIds {
+			for _, existing := range existingByTenant[tenantId] {
+				id := fmt.Sprint(existing["id"])
+				if matched[id] {
+					continue
+				}
+				plan.Changes = append(plan.Changes, &Change{
+					Action:   ActionDelete,
+					Id:       id,
+					Name:     fmt.Sprint(existing["name"]),
+					TenantId: tenantId,
+				})
+			}
+		}
+	}
+
+	return plan, nil
+}
+
+// Finds the existing instance for the desired instance, by ID if set and otherwise by name. Returns nil if there is no such instance.
+func matchInstance(desired *DesiredInstance, existing []map[string]any) (map[string]any, error) {
+	if desired.Id != "" {
+		for _, instance := range existing {
+			if instance["id"] == desired.Id {
+				return instance, nil
+			}
+		}
+		return nil, fmt.Errorf("instance %s with ID %s was not found in tenant %s", desired.Name, desired.Id, desired.TenantId)
+	}
+
+	matches := []map[string]any{}
+	for _, instance := range existing {
+		if instance["name"] == desired.Name {
+			matches = append(matches, instance)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return nil, nil
+	case 1:
+		return matches[0], nil
+	default:
+		ids := []string{}
+		for _, match := range matches {
+			ids = append(ids, fmt.Sprint(match["id"]))
+		}
+		sort.Strings(ids)
+		return nil, fmt.Errorf("instance name %s matches more than one instance in tenant %s (%s), set the id of the instance to use", desired.Name, desired.TenantId, strings.Join(ids, ", "))
+	}
+}
+
+// Returns the change needed to turn the existing instance into the desired instance, and the differences that cannot be changed on an existing instance
+func diffInstance(desired *DesiredInstance, existing map[string]any) (*Change, []string) {
+	change := &Change{
+		Action:   ActionNoOp,
+		Name:     desired.Name,
+		TenantId: desired.TenantId,
+		Details:  []string{},
+		body:     map[string]any{},
+	}
+	conflicts := []string{}
+
+	if existingName := fmt.Sprint(existing["name"]); existingName != desired.Name {
+		change.Details = append(change.Details, fmt.Sprintf("name: %s to %s", existingName, desired.Name))
+		change.body["name"] = desired.Name
+	}
+
+	immutable := map[string]string{"type": desired.Type}
+	// Older instances do not report their version, it is only compared when present
+	if _, ok := existing["version"]; ok {
+		immutable["version"] = desired.Version
+	}
+	if desired.Type != "free-db" {
+		immutable["region"] = desired.Region
+		immutable["cloud_provider"] = desired.CloudProvider
+		immutable["customer_managed_key_id"] = desired.CustomerManagedKeyId
+	}
+	for _, field := range []string{"type", "version", "region", "cloud_provider", "customer_managed_key_id"} {
+		want, ok := immutable[field]
+		if !ok {
+			continue
+		}
+		got, _ := existing[field].(string)
+		if got != want {
+			conflicts = append(conflicts, fmt.Sprintf("%s of instance %s cannot be changed from %q to %q, the instance has to be recreated", field, desired.Name, got, want))
+		}
+	}
+
+	if desired.Type != "free-db" {
+		if existingMemory := fmt.Sprint(existing["memory"]); existingMemory != desired.Memory {
+			change.Details = append(change.Details, fmt.Sprintf("memory: %s to %s", existingMemory, desired.Memory))
+			change.body["memory"] = desired.Memory
+		}
+
+		toggles := map[string]bool{"vector_optimized": desired.VectorOptimized}
+		if desired.Type == "professional-db" {
+			toggles["graph_analytics_plugin"] = desired.GraphAnalyticsPlugin
+		}
+		for _, field := range []string{"vector_optimized", "graph_analytics_plugin"} {
+			want, ok := toggles[field]
+			if !ok {
+				continue
+			}
+			// Older instances do not report these fields, they are only compared when present
+			if got, ok := existing[field].(bool); ok && got != want {
+				change.Details = append(change.Details, fmt.Sprintf("%s: %t to %t", field, got, want))
+				change.body[field] = want
+			}
+		}
+	}
+
+	if len(change.body) > 0 {
+		change.Action = ActionUpdate
+	}
+
+	return change, conflicts
+}
+
+func listInstances(cfg *clicfg.Config, tenantId string) ([]map[string]any, error) {
+	resBody, statusCode, err := api.MakeRequest(cfg, "/instances", &api.RequestConfig{
+		Method:      http.MethodGet,
+		QueryParams: map[string]string{"tenantId": tenantId},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if statusCode != http.StatusOK {
+		return []map[string]any{}, nil
+	}
+
+	return api.ParseBody(resBody).AsArray(), nil
+}
+
+func getInstance(cfg *clicfg.Config, id string) (map[string]any, error) {
+	resBody, _, err := api.MakeRequest(cfg, fmt.Sprintf("/instances/%s", id), &api.RequestConfig{
+		Method: http.MethodGet,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return api.ParseBody(resBody).GetSingleOrError()
+}
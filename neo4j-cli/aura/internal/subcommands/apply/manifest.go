@@ -0,0 +1,134 @@
+// Copyright (c) "Neo4j"
+// Neo4j Sweden AB [http://neo4j.com]
+
+package apply
+
+import (
+	"bytes"
+
+	"github.com/neo4j/cli/common/clicfg"
+	"github.com/neo4j/cli/common/clierr"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/flags"
+	"github.com/spf13/afero"
+	"go.yaml.in/yaml/v3"
+)
+
+type Manifest struct {
+	Instances []*DesiredInstance `yaml:"instances"`
+}
+
+// Desired state of an instance, instances are matched by id when set, otherwise by name
+type DesiredInstance struct {
+	Id                   string `yaml:"id"`
+	Name                 string `yaml:"name"`
+	TenantId             string `yaml:"tenant_id"`
+	Type                 string `yaml:"type"`
+	Region               string `yaml:"region"`
+	Memory               string `yaml:"memory"`
+	CloudProvider        string `yaml:"cloud_provider"`
+	Version              string `yaml:"version"`
+	CustomerManagedKeyId string `yaml:"customer_managed_key_id"`
+	VectorOptimized      bool   `yaml:"vector_optimized"`
+	GraphAnalyticsPlugin bool   `yaml:"graph_analytics_plugin"`
+}
+
+// Reads and validates the manifest file, filling in the default tenant and version where they are not set
+func ReadManifest(cfg *clicfg.Config, path string) (*Manifest, error) {
+	data, err := afero.ReadFile(cfg.Aura.Fs(), path)
+	if err != nil {
+		return nil, clierr.NewUsageError("cannot read file %s: %s", path, err)
+	}
+
+	var manifest Manifest
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+	if err := decoder.Decode(&manifest); err != nil {
+		return nil, clierr.NewUsageError("invalid file %s: %s", path, err)
+	}
+
+	names := map[string]bool{}
+	for i, instance := range manifest.Instances {
+		if instance.Name == "" {
+			return nil, clierr.NewUsageError("instance %d in %s has no name", i+1, path)
+		}
+		if instance.TenantId == "" {
+			instance.TenantId = cfg.Aura.DefaultTenant()
+		}
+		if instance.TenantId == "" {
+			return nil, clierr.NewUsageError("instance %s has no tenant_id and no default tenant is configured", instance.Name)
+		}
+		key := instance.TenantId + "/" + instance.Name
+		if names[key] {
+			return nil, clierr.NewUsageError("instance %s is defined more than once for tenant %s", instance.Name, instance.TenantId)
+		}
+		names[key] = true
+
+		var instanceType flags.InstanceType
+		if err := instanceType.Set(instance.Type); err != nil {
+			return nil, clierr.NewUsageError("invalid type for instance %s: %s", instance.Name, err)
+		}
+		if instance.Type == "free-db" {
+			continue
+		}
+
+		if instance.Version == "" {
+			instance.Version = "5"
+		}
+		if instance.Version != "4" && instance.Version != "5" {
+			return nil, clierr.NewUsageError(`invalid version for instance %s: must be one of "4" or "5"`, instance.Name)
+		}
+		var memory flags.Memory
+		if err := memory.Set(instance.Memory); err != nil {
+			return nil, clierr.NewUsageError("invalid memory for instance %s: %s", instance.Name, err)
+		}
+		// The instance configurations of the tenants are not retrieved, so the memory is only checked against the sizes Aura has
+		if err := flags.CheckKnownMemory(instance.Memory); err != nil {
+			return nil, clierr.NewUsageError("invalid memory for instance %s: %s", instance.Name, err)
+		}
+		var cloudProvider flags.CloudProvider
+		if err := cloudProvider.Set(instance.CloudProvider); err != nil {
+			return nil, clierr.NewUsageError("invalid cloud_provider for instance %s: %s", instance.Name, err)
+		}
+		if instance.Region == "" {
+			return nil, clierr.NewUsageError("instance %s has no region", instance.Name)
+		}
+		if instance.GraphAnalyticsPlugin && instance.Type != "professional-db" {
+			return nil, clierr.NewUsageError("graph_analytics_plugin of instance %s can only be set when type is professional-db", instance.Name)
+		}
+	}
+
+	return &manifest, nil
+}
+
+// Returns the body of the create instance request for the desired instance
+func (instance *DesiredInstance) createBody() map[string]any {
+	if instance.Type == "free-db" {
+		return map[string]any{
+			"version":        "5",
+			"region":         "europe-west1",
+			"memory":         "1GB",
+			"name":           instance.Name,
+			"type":           instance.Type,
+			"tenant_id":      instance.TenantId,
+			"cloud_provider": "gcp",
+		}
+	}
+
+	body := map[string]any{
+		"version":          instance.Version,
+		"region":           instance.Region,
+		"memory":           instance.Memory,
+		"name":             instance.Name,
+		"type":             instance.Type,
+		"tenant_id":        instance.TenantId,
+		"cloud_provider":   instance.CloudProvider,
+		"vector_optimized": instance.VectorOptimized,
+	}
+	if instance.Type == "professional-db" {
+		body["graph_analytics_plugin"] = instance.GraphAnalyticsPlugin
+	}
+	if instance.CustomerManagedKeyId != "" {
+		body["customer_managed_key_id"] = instance.CustomerManagedKeyId
+	}
+	return body
+}
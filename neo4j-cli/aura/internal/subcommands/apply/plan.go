@@ -0,0 +1,63 @@
+// Copyright (c) "Neo4j"
+// Neo4j Sweden AB [http://neo4j.com]
+
+package apply
+
+import (
+	"github.com/neo4j/cli/common/clicfg"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/api"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/output"
+	"github.com/spf13/cobra"
+)
+
+func NewPlanCmd(cfg *clicfg.Config) *cobra.Command {
+	var (
+		file  string
+		prune bool
+	)
+
+	const (
+		fileFlag  = "file"
+		pruneFlag = "prune"
+	)
+
+	cmd := &cobra.Command{
+		Use:   "plan",
+		Short: "Shows the changes apply would make to match a YAML file",
+		Long: `Compares the desired instances of a YAML file to the existing instances and shows the changes the apply command would make, without making them. See the apply command for the layout of the file.
+
+Each instance is listed with one of the actions create, update, delete or no-op. Deletes are only planned with --prune.
+
+Changes that cannot be applied, such as changing the region of an existing instance, are reported as an error after the plan.`,
+		Args:    cobra.NoArgs,
+		PreRunE: bindFlags(cfg),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			manifest, err := ReadManifest(cfg, file)
+			if err != nil {
+				return err
+			}
+
+			cmd.SilenceUsage = true
+			plan, err := ComputePlan(cfg, manifest, prune)
+			if err != nil {
+				return err
+			}
+
+			output.PrintBodyMap(cmd, cfg, api.NewListResponseData(plan.rows()), []string{"action", "id", "name", "tenant_id", "changes"})
+			if len(plan.Conflicts) > 0 {
+				return conflictsError(plan)
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&file, fileFlag, "f", "", "(required) Path to the YAML file listing the desired instances")
+	cmd.MarkFlagRequired(fileFlag)
+
+	cmd.Flags().BoolVar(&prune, pruneFlag, false, "Plans the deletion of existing instances of the tenants used in the file that are not listed in the file")
+
+	addConnectionFlags(cmd)
+
+	return cmd
+}
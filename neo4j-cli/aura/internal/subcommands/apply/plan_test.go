@@ -0,0 +1,272 @@
+// Copyright (c) "Neo4j"
+// Neo4j Sweden AB [http://neo4j.com]
+
+package apply_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/test/testutils"
+)
+
+const manifest = `
+instances:
+  - name: production
+    tenant_id: tenant1
+    type: enterprise-db
+    cloud_provider: gcp
+    region: europe-west1
+    memory: 16GB
+  - name: staging
+    tenant_id: tenant1
+    type: professional-db
+    cloud_provider: gcp
+    region: europe-west1
+    memory: 2GB
+`
+
+func mockInstances(helper *testutils.AuraTestHelper) *testutils.AuraTestHelper {
+	helper.NewRequestHandlerMock("GET /v1/instances", http.StatusOK, `{
+		"data": [
+			{
+				"id": "prod1",
+				"name": "production",
+				"tenant_id": "tenant1",
+				"cloud_provider": "gcp"
+			},
+			{
+				"id": "old1",
+				"name": "old",
+				"tenant_id": "tenant1",
+				"cloud_provider": "gcp"
+			}
+		]
+	}`)
+	helper.NewRequestHandlerMock("GET /v1/instances/prod1", http.StatusOK, `{
+		"data": {
+			"id": "prod1",
+			"name": "production",
+			"tenant_id": "tenant1",
+			"status": "running",
+			"cloud_provider": "gcp",
+			"region": "europe-west1",
+			"type": "enterprise-db",
+			"memory": "8GB",
+			"version": "5",
+			"vector_optimized": false
+		}
+	}`)
+	return helper
+}
+
+func TestPlan(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	mockInstances(&helper)
+	helper.SetFile("instances.yaml", manifest)
+
+	helper.ExecuteCommand("plan -f instances.yaml")
+
+	helper.AssertErr("")
+	helper.AssertOutJson(`{
+		"data": [
+			{
+				"action": "update",
+				"changes": "memory: 8GB to 16GB",
+				"id": "prod1",
+				"name": "production",
+				"tenant_id": "tenant1"
+			},
+			{
+				"action": "create",
+				"changes": "",
+				"id": "",
+				"name": "staging",
+				"tenant_id": "tenant1"
+			}
+		]
+	}`)
+}
+
+func TestPlanWithPrune(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	mockInstances(&helper)
+	helper.SetFile("instances.yaml", manifest)
+
+	helper.ExecuteCommand("plan -f instances.yaml --prune --output table")
+
+	helper.AssertErr("")
+	helper.AssertOut(`
+┌────────┬───────┬────────────┬───────────┬─────────────────────┐
+│ ACTION │ ID    │ NAME       │ TENANT_ID │ CHANGES             │
+├────────┼───────┼────────────┼───────────┼─────────────────────┤
+│ update │ prod1 │ production │ tenant1   │ memory: 8GB to 16GB │
+│ create │       │ staging    │ tenant1   │                     │
+│ delete │ old1  │ old        │ tenant1   │                     │
+└────────┴───────┴────────────┴───────────┴─────────────────────┘
+	`)
+}
+
+func TestPlanWithDefaultTenant(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.SetConfigValue("aura.default-tenant", "tenant2")
+	listMock := helper.NewRequestHandlerMock("GET /v1/instances", http.StatusOK, `{"data": []}`)
+	helper.SetFile("instances.yaml", `
+instances:
+  - name: free
+    type: free-db
+`)
+
+	helper.ExecuteCommand("plan -f instances.yaml")
+
+	helper.AssertErr("")
+	listMock.AssertCalledWithQueryParam("tenantId", "tenant2")
+	helper.AssertOutJson(`{
+		"data": [
+			{
+				"action": "create",
+				"changes": "",
+				"id": "",
+				"name": "free",
+				"tenant_id": "tenant2"
+			}
+		]
+	}`)
+}
+
+func TestPlanWithImmutableChange(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	mockInstances(&helper)
+	helper.SetFile("instances.yaml", `
+instances:
+  - name: production
+    tenant_id: tenant1
+    type: enterprise-db
+    cloud_provider: gcp
+    region: us-east1
+    memory: 8GB
+`)
+
+	helper.ExecuteCommand("plan -f instances.yaml")
+
+	helper.AssertErr(`Error: the file cannot be applied:
+  region of instance production cannot be changed from "europe-west1" to "us-east1", the instance has to be recreated`)
+}
+
+func TestPlanWithVersionChange(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	mockInstances(&helper)
+	helper.SetFile("instances.yaml", `
+instances:
+  - name: production
+    tenant_id: tenant1
+    type: enterprise-db
+    cloud_provider: gcp
+    region: europe-west1
+    memory: 8GB
+    version: "4"
+`)
+
+	helper.ExecuteCommand("plan -f instances.yaml")
+
+	helper.AssertErr(`Error: the file cannot be applied:
+  version of instance production cannot be changed from "5" to "4", the instance has to be recreated`)
+}
+
+func TestPlanWithAmbiguousName(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.NewRequestHandlerMock("GET /v1/instances", http.StatusOK, `{
+		"data": [
+			{"id": "a1", "name": "production", "tenant_id": "tenant1", "cloud_provider": "gcp"},
+			{"id": "b2", "name": "production", "tenant_id": "tenant1", "cloud_provider": "gcp"}
+		]
+	}`)
+	helper.SetFile("instances.yaml", manifest)
+
+	helper.ExecuteCommand("plan -f instances.yaml")
+
+	helper.AssertErr(`Error: the file cannot be applied:
+  instance name production matches more than one instance in tenant tenant1 (a1, b2), set the id of the instance to use`)
+}
+
+func TestPlanWithInvalidFile(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	tests := map[string]struct {
+		content       string
+		expectedError string
+	}{
+		"invalid memory": {
+			content: `
+instances:
+  - name: production
+    tenant_id: tenant1
+    type: enterprise-db
+    cloud_provider: gcp
+    region: europe-west1
+    memory: 3GB
+`,
+			expectedError: `Error: invalid memory for instance production: must be one of "1GB", "2GB", "4GB", "8GB", "16GB", "24GB", "32GB", "48GB", "64GB", "128GB", "192GB", "256GB", "384GB", or "512GB"`,
+		},
+		"unknown field": {
+			content: `
+instances:
+  - name: production
+    size: 8GB
+`,
+			expectedError: "Error: invalid file instances.yaml: yaml: unmarshal errors:\n  line 4: field size not found in type apply.DesiredInstance",
+		},
+		"missing tenant": {
+			content: `
+instances:
+  - name: production
+    type: free-db
+`,
+			expectedError: "Error: instance production has no tenant_id and no default tenant is configured",
+		},
+		"duplicate instance": {
+			content: `
+instances:
+  - name: free
+    tenant_id: tenant1
+    type: free-db
+  - name: free
+    tenant_id: tenant1
+    type: free-db
+`,
+			expectedError: "Error: instance free is defined more than once for tenant tenant1",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			helper.SetFile("instances.yaml", tt.content)
+
+			helper.ExecuteCommand("plan -f instances.yaml")
+
+			helper.AssertErr(tt.expectedError)
+		})
+	}
+}
+
+func TestPlanWithMissingFile(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.ExecuteCommand("plan -f missing.yaml")
+
+	helper.AssertErr("Error: cannot read file missing.yaml: open missing.yaml: file does not exist")
+}
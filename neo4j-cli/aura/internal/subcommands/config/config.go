@@ -18,6 +18,8 @@ func NewCmd(cfg *clicfg.Config) *cobra.Command {
 	cmd.AddCommand(NewGetCmd(cfg))
 	cmd.AddCommand(NewListCmd(cfg))
 	cmd.AddCommand(NewSetCmd(cfg))
+	cmd.AddCommand(NewUnsetCmd(cfg))
+	cmd.AddCommand(NewDescribeCmd(cfg))
 	if cfg.Aura.AuraBetaEnabled() {
 		cmd.AddCommand(project.NewCmd(cfg))
 	}
@@ -0,0 +1,71 @@
+// Copyright (c) "Neo4j"
+// Neo4j Sweden AB [http://neo4j.com]
+
+package config
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/neo4j/cli/common/clicfg"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/api"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/output"
+	"github.com/spf13/cobra"
+)
+
+func NewDescribeCmd(cfg *clicfg.Config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "describe",
+		Short: "Describes every configuration key and where its current value comes from",
+		Long: `Lists every configuration key with its description, default value, current value and the source of the current value, which is one of:
+  default  the key is not set
+  file     the key is set in the config file
+  local    the key is set in the project-local .aura-cli.json file, found in the current directory or one of its parents, which can only set default-tenant and output
+  env      the key is set by an environment variable
+  flag     the key is set by a flag of this command`,
+		Args: cobra.NoArgs,
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			cfg.Aura.BindBaseUrl(cmd.Flags().Lookup("base-url"))
+			cfg.Aura.BindAuthUrl(cmd.Flags().Lookup("auth-url"))
+
+			if outputValue := cmd.Flags().Lookup("output").Value.String(); outputValue != "" {
+				if _, err := cfg.Aura.ParseValue("output", outputValue); err != nil {
+					return err
+				}
+			}
+			cfg.Aura.BindOutput(cmd.Flags().Lookup("output"))
+
+			return nil
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			keys := []map[string]any{}
+			for _, key := range clicfg.AuraConfigKeys {
+				value := cfg.Aura.Get(key.Name)
+				if value == nil {
+					value = ""
+				}
+				defaultValue := key.Default
+				if defaultValue == nil {
+					defaultValue = ""
+				}
+				keys = append(keys, map[string]any{
+					"key":         key.Name,
+					"value":       value,
+					"source":      cfg.Aura.Source(key.Name),
+					"default":     defaultValue,
+					"env":         key.EnvVar,
+					"description": key.Description,
+				})
+			}
+
+			output.PrintBodyMap(cmd, cfg, api.NewListResponseData(keys), []string{"key", "value", "source", "default", "env", "description"})
+			return nil
+		},
+	}
+
+	cmd.Flags().String("auth-url", "", "")
+	cmd.Flags().String("base-url", "", "")
+	cmd.Flags().String("output", "", fmt.Sprintf("Format to print console output in, from a choice of [%s]", strings.Join(clicfg.ValidOutputValues[:], ", ")))
+
+	return cmd
+}
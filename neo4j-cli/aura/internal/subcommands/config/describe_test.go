@@ -0,0 +1,92 @@
+// Copyright (c) "Neo4j"
+// Neo4j Sweden AB [http://neo4j.com]
+
+package config_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/neo4j/cli/common/clicfg"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/test/testutils"
+)
+
+func TestDescribeConfig(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.OverwriteConfig(`{"aura": {"beta-enabled": true}}`)
+	t.Setenv("AURA_AUTH_URL", "https://example.com/oauth/token")
+
+	helper.ExecuteCommand("config describe --output json --base-url https://example.com")
+
+	helper.AssertErr("")
+	helper.AssertOutJson(fmt.Sprintf(`{
+		"data": [
+			{
+				"default": "%s",
+				"description": "URL used to retrieve access tokens for the Aura API",
+				"env": "AURA_AUTH_URL",
+				"key": "auth-url",
+				"source": "env",
+				"value": "https://example.com/oauth/token"
+			},
+			{
+				"default": "%s",
+				"description": "Base URL of the Aura API",
+				"env": "AURA_BASE_URL",
+				"key": "base-url",
+				"source": "flag",
+				"value": "https://example.com"
+			},
+			{
+				"default": "",
+				"description": "Tenant/project ID used by commands when --tenant-id is not set",
+				"env": "",
+				"key": "default-tenant",
+				"source": "default",
+				"value": ""
+			},
+			{
+				"default": "default",
+				"description": "Format to print console output in, from a choice of [default, json, table, csv]",
+				"env": "",
+				"key": "output",
+				"source": "flag",
+				"value": "json"
+			},
+			{
+				"default": false,
+				"description": "Enables beta commands and the beta versions of the Aura API",
+				"env": "",
+				"key": "beta-enabled",
+				"source": "file",
+				"value": true
+			},
+			{
+				"default": "1h",
+				"description": "How long data such as the instance configurations of tenants is cached for, e.g. 30m, 0 disables the cache",
+				"env": "",
+				"key": "cache-ttl",
+				"source": "default",
+				"value": "1h"
+			},
+			{
+				"default": "",
+				"description": "Comma separated instance IDs or regular expressions matching instance names of production instances, which are snapshotted before being overwritten or deleted",
+				"env": "",
+				"key": "production-instances",
+				"source": "default",
+				"value": ""
+			},
+			{
+				"default": "",
+				"description": "Comma separated IDs or regular expressions matching names of resources that destructive commands refuse to change without --force",
+				"env": "",
+				"key": "protected-resources",
+				"source": "default",
+				"value": ""
+			}
+		]
+	}`, clicfg.DefaultAuraAuthUrl, clicfg.DefaultAuraBaseUrl))
+}
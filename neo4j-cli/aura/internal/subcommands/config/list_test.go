@@ -19,5 +19,5 @@ func TestListConfig(t *testing.T) {
 
 	helper.ExecuteCommand("config list")
 
-	helper.AssertOutJson(fmt.Sprintf(`{"auth-url": "%s","base-url": "%s","beta-enabled": false,"output": "default"}`, clicfg.DefaultAuraAuthUrl, clicfg.DefaultAuraBaseUrl))
+	helper.AssertOutJson(fmt.Sprintf(`{"auth-url": "%s","base-url": "%s","beta-enabled": false,"cache-ttl": "1h","output": "default"}`, clicfg.DefaultAuraAuthUrl, clicfg.DefaultAuraBaseUrl))
 }
@@ -0,0 +1,183 @@
+// Copyright (c) "Neo4j"
+// Neo4j Sweden AB [http://neo4j.com]
+
+package project
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/neo4j/cli/common/clicfg"
+	"github.com/neo4j/cli/common/clicfg/projects"
+	"github.com/neo4j/cli/common/clierr"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/api"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/output"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/subcommands/utils"
+	"github.com/spf13/cobra"
+)
+
+func NewDiscoverCmd(cfg *clicfg.Config) *cobra.Command {
+	var all bool
+
+	const allFlag = "all"
+
+	cmd := &cobra.Command{
+		Use:   "discover",
+		Short: "Discovers the organizations and projects visible to the current credential",
+		Long: `Lists the organizations and projects visible to the current credential and offers to add each project that is not yet configured, using a name generated from the project name. Use --all to add every discovered project without prompting.
+
+Configured projects that are not visible to the current credential are reported, as they may no longer exist.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			discovered, err := discoverProjects(cfg)
+			if err != nil {
+				return err
+			}
+
+			stored, err := cfg.Aura.Projects.List()
+			if err != nil {
+				return err
+			}
+
+			storedNames := map[string]string{}
+			for name, project := range stored.Projects {
+				storedNames[projectKey(project.OrganizationId, project.ProjectId)] = name
+			}
+
+			discoveredKeys := map[string]bool{}
+			rows := []map[string]any{}
+			for _, d := range discovered {
+				discoveredKeys[projectKey(d.OrganizationId, d.ProjectId)] = true
+				rows = append(rows, map[string]any{
+					"organization_id":   d.OrganizationId,
+					"organization_name": d.OrganizationName,
+					"project_id":        d.ProjectId,
+					"project_name":      d.ProjectName,
+					"config_name":       storedNames[projectKey(d.OrganizationId, d.ProjectId)],
+				})
+			}
+			output.PrintBodyMap(cmd, cfg, api.NewListResponseData(rows), []string{"organization_id", "organization_name", "project_id", "project_name", "config_name"})
+
+			for _, name := range sortedProjectNames(stored.Projects) {
+				project := stored.Projects[name]
+				if !discoveredKeys[projectKey(project.OrganizationId, project.ProjectId)] {
+					cmd.PrintErrf("Warning: configured project %s with organization ID %s and project ID %s is not visible to the current credential and may no longer exist\n", name, project.OrganizationId, project.ProjectId)
+				}
+			}
+
+			for _, d := range discovered {
+				if _, ok := storedNames[projectKey(d.OrganizationId, d.ProjectId)]; ok {
+					continue
+				}
+
+				name := generateProjectName(d.ProjectName, d.ProjectId, stored.Projects)
+				if !all {
+					add, err := utils.Confirm(cmd, fmt.Sprintf("Add project %s (%s) of organization %s as %s?", d.ProjectName, d.ProjectId, d.OrganizationName, name))
+					if err != nil {
+						return err
+					}
+					if !add {
+						continue
+					}
+				}
+
+				if err := cfg.Aura.Projects.Add(name, d.OrganizationId, d.ProjectId); err != nil {
+					return err
+				}
+				stored.Projects[name] = &projects.AuraProject{OrganizationId: d.OrganizationId, ProjectId: d.ProjectId}
+				cmd.PrintErrf("Added project %s with organization ID %s and project ID %s\n", name, d.OrganizationId, d.ProjectId)
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVar(&all, allFlag, false, "Adds all discovered projects that are not configured yet without prompting")
+
+	return cmd
+}
+
+type discoveredProject struct {
+	OrganizationId   string
+	OrganizationName string
+	ProjectId        string
+	ProjectName      string
+}
+
+func discoverProjects(cfg *clicfg.Config) ([]discoveredProject, error) {
+	organizations, err := listV2(cfg, "/organizations")
+	if err != nil {
+		return nil, err
+	}
+
+	discovered := []discoveredProject{}
+	for _, organization := range organizations {
+		organizationId := fmt.Sprint(organization["id"])
+		projects, err := listV2(cfg, fmt.Sprintf("/organizations/%s/projects", organizationId))
+		if err != nil {
+			return nil, err
+		}
+
+		for _, project := range projects {
+			discovered = append(discovered, discoveredProject{
+				OrganizationId:   organizationId,
+				OrganizationName: fmt.Sprint(organization["name"]),
+				ProjectId:        fmt.Sprint(project["id"]),
+				ProjectName:      fmt.Sprint(project["name"]),
+			})
+		}
+	}
+
+	return discovered, nil
+}
+
+func listV2(cfg *clicfg.Config, path string) ([]map[string]any, error) {
+	resBody, statusCode, err := api.MakeRequest(cfg, path, &api.RequestConfig{
+		Method:  http.MethodGet,
+		Version: api.AuraApiVersion2,
+	})
+	if err != nil {
+		return nil, err
+	}
+	// Listing nothing would report every configured project as missing
+	if statusCode != http.StatusOK {
+		return nil, clierr.NewUpstreamError("cannot list %s, unexpected status %d", path, statusCode)
+	}
+
+	return api.ParseBody(resBody).AsArray(), nil
+}
+
+func projectKey(organizationId string, projectId string) string {
+	return organizationId + "/" + projectId
+}
+
+var nonAlphanumeric = regexp.MustCompile(`[^a-z0-9]+`)
+
+// Generates a config name from the project name, adding a numeric suffix if the name is already taken
+func generateProjectName(projectName string, projectId string, existing map[string]*projects.AuraProject) string {
+	base := strings.Trim(nonAlphanumeric.ReplaceAllString(strings.ToLower(projectName), "-"), "-")
+	if base == "" {
+		base = projectId
+	}
+
+	name := base
+	for i := 2; ; i++ {
+		if _, ok := existing[name]; !ok {
+			return name
+		}
+		name = fmt.Sprintf("%s-%d", base, i)
+	}
+}
+
+func sortedProjectNames(projects map[string]*projects.AuraProject) []string {
+	names := make([]string, 0, len(projects))
+	for name := range projects {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
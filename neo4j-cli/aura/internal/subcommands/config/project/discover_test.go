@@ -0,0 +1,137 @@
+// Copyright (c) "Neo4j"
+// Neo4j Sweden AB [http://neo4j.com]
+
+package project_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/neo4j/cli/common/clicfg/projects"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/test/testutils"
+	"github.com/stretchr/testify/assert"
+)
+
+func mockDiscovery(helper *testutils.AuraTestHelper) {
+	helper.NewRequestHandlerMock("GET /v2beta1/organizations", http.StatusOK, `{
+		"data": [
+			{
+				"id": "org1",
+				"name": "Acme"
+			}
+		]
+	}`)
+	helper.NewRequestHandlerMock("GET /v2beta1/organizations/org1/projects", http.StatusOK, `{
+		"data": [
+			{
+				"id": "proj1",
+				"name": "Production"
+			},
+			{
+				"id": "proj2",
+				"name": "Dev Team #2"
+			}
+		]
+	}`)
+}
+
+func TestDiscoverProjectsWithAll(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.SetConfigValue("aura.beta-enabled", true)
+	mockDiscovery(&helper)
+
+	helper.ExecuteCommand("config project discover --all")
+
+	helper.AssertErr(`Added project production with organization ID org1 and project ID proj1
+Added project dev-team-2 with organization ID org1 and project ID proj2`)
+	assert.NotContains(t, helper.PrintOut(), "Added project")
+	helper.AssertConfigValue("aura-projects.projects", `
+	{
+		"dev-team-2": {
+			"organization-id": "org1",
+			"project-id": "proj2"
+		},
+		"production": {
+			"organization-id": "org1",
+			"project-id": "proj1"
+		}
+	}`)
+	helper.AssertConfigValue("aura-projects.default", "production")
+}
+
+func TestDiscoverProjectsInteractively(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.SetConfigValue("aura.beta-enabled", true)
+	mockDiscovery(&helper)
+
+	helper.SetInput("n\ny\n")
+
+	helper.ExecuteCommand("config project discover")
+
+	helper.AssertErr("Added project dev-team-2 with organization ID org1 and project ID proj2")
+	out := helper.PrintOut()
+	assert.Contains(t, out, "Add project Production (proj1) of organization Acme as production? [y/N]: ")
+	assert.Contains(t, out, "Add project Dev Team #2 (proj2) of organization Acme as dev-team-2? [y/N]: ")
+	helper.AssertConfigValue("aura-projects.projects", `
+	{
+		"dev-team-2": {
+			"organization-id": "org1",
+			"project-id": "proj2"
+		}
+	}`)
+}
+
+func TestDiscoverProjectsSkipsConfiguredAndWarnsAboutMissing(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.SetConfigValue("aura.beta-enabled", true)
+	helper.SetConfigValue("aura-projects.projects", map[string]*projects.AuraProject{
+		"prod":    {OrganizationId: "org1", ProjectId: "proj1"},
+		"removed": {OrganizationId: "org1", ProjectId: "gone"},
+	})
+	helper.SetConfigValue("aura-projects.default", "prod")
+	mockDiscovery(&helper)
+
+	helper.ExecuteCommand("config project discover --all")
+
+	helper.AssertErr(`Warning: configured project removed with organization ID org1 and project ID gone is not visible to the current credential and may no longer exist
+Added project dev-team-2 with organization ID org1 and project ID proj2`)
+	helper.AssertConfigValue("aura-projects.projects", `
+	{
+		"dev-team-2": {
+			"organization-id": "org1",
+			"project-id": "proj2"
+		},
+		"prod": {
+			"organization-id": "org1",
+			"project-id": "proj1"
+		},
+		"removed": {
+			"organization-id": "org1",
+			"project-id": "gone"
+		}
+	}`)
+	helper.AssertConfigValue("aura-projects.default", "prod")
+}
+
+func TestDiscoverProjectsWithForbiddenProjects(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.SetConfigValue("aura.beta-enabled", true)
+	helper.SetConfigValue("aura-projects.projects", map[string]*projects.AuraProject{
+		"prod": {OrganizationId: "org1", ProjectId: "proj1"},
+	})
+	helper.NewRequestHandlerMock("GET /v2beta1/organizations", http.StatusOK, `{"data": [{"id": "org1", "name": "Acme"}]}`)
+	helper.NewRequestHandlerMock("GET /v2beta1/organizations/org1/projects", http.StatusForbidden, `{"error": "access to the projects of organization org1 is forbidden"}`)
+
+	helper.ExecuteCommand("config project discover --all")
+
+	helper.AssertOut("")
+	helper.AssertErr("Error: access to the projects of organization org1 is forbidden")
+}
@@ -19,6 +19,7 @@ func NewCmd(cfg *clicfg.Config) *cobra.Command {
 	cmd.AddCommand(NewUseCmd(cfg))
 	cmd.AddCommand(NewListCmd(cfg))
 	cmd.AddCommand(NewRemoveCmd(cfg))
+	cmd.AddCommand(NewDiscoverCmd(cfg))
 
 	return cmd
 }
@@ -5,7 +5,6 @@ package config
 
 import (
 	"github.com/neo4j/cli/common/clicfg"
-	"github.com/neo4j/cli/common/clierr"
 	"github.com/spf13/cobra"
 )
 
@@ -18,29 +17,11 @@ func NewSetCmd(cfg *clicfg.Config) *cobra.Command {
 				return err
 			}
 
-			if !cfg.Aura.IsValidConfigKey(args[0]) {
-				return clierr.NewUsageError("invalid config key specified: %s", args[0])
-			}
-
-			if args[0] == "output" {
-				validOutputValue := false
-				for _, v := range clicfg.ValidOutputValues {
-					if v == args[1] {
-						validOutputValue = true
-						break
-					}
-				}
-				if !validOutputValue {
-					return clierr.NewUsageError("invalid output value specified: %s", args[1])
-				}
-			}
-
-			return nil
+			_, err := cfg.Aura.ParseValue(args[0], args[1])
+			return err
 		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			cfg.Aura.Set(args[0], args[1])
-
-			return nil
+			return cfg.Aura.Set(args[0], args[1])
 		},
 	}
 }
@@ -56,3 +56,55 @@ func TestSetBetaEnabledConfig(t *testing.T) {
 
 	helper.AssertConfigValue("aura.beta-enabled", "false")
 }
+
+func TestSetConfigWithInvalidBooleanValue(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.OverwriteConfig("{}")
+
+	helper.ExecuteCommand("config set beta-enabled yes-please")
+
+	helper.AssertErr("Error: invalid boolean value specified: yes-please")
+}
+
+func TestSetCacheTtlConfig(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.OverwriteConfig("{}")
+
+	helper.ExecuteCommand("config set cache-ttl 30m")
+
+	helper.AssertConfigValue("aura.cache-ttl", "30m")
+
+	helper.ExecuteCommand("config set cache-ttl soon")
+
+	helper.AssertErr("Error: invalid duration value specified: soon")
+}
+
+func TestSetBaseUrlConfigRemovesTrailingPath(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.OverwriteConfig("{}")
+
+	helper.ExecuteCommand("config set base-url https://api.neo4j.io/v1")
+
+	helper.AssertConfigValue("aura.base-url", "https://api.neo4j.io")
+}
+
+func TestSetProductionInstances(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.OverwriteConfig("{}")
+
+	helper.ExecuteCommand("config set production-instances ^prod-,2f49c2b3")
+
+	helper.AssertConfigValue("aura.production-instances", `["^prod-", "2f49c2b3"]`)
+
+	helper.ExecuteCommand("config set production-instances (prod")
+
+	helper.AssertErr("Error: invalid regular expression specified: (prod")
+}
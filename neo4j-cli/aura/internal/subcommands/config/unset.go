@@ -0,0 +1,21 @@
+// Copyright (c) "Neo4j"
+// Neo4j Sweden AB [http://neo4j.com]
+
+package config
+
+import (
+	"github.com/neo4j/cli/common/clicfg"
+	"github.com/spf13/cobra"
+)
+
+func NewUnsetCmd(cfg *clicfg.Config) *cobra.Command {
+	return &cobra.Command{
+		Use:       "unset <key>",
+		Short:     "Removes the specified configuration value so that its default value is used",
+		ValidArgs: cfg.Aura.ValidConfigKeys[:],
+		Args:      cobra.MatchAll(cobra.ExactArgs(1), cobra.OnlyValidArgs),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return cfg.Aura.Unset(args[0])
+		},
+	}
+}
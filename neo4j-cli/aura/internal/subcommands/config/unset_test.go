@@ -0,0 +1,33 @@
+// Copyright (c) "Neo4j"
+// Neo4j Sweden AB [http://neo4j.com]
+
+package config_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/test/testutils"
+)
+
+func TestUnsetConfig(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.SetConfigValue("aura.output", "table")
+
+	helper.ExecuteCommand("config unset output")
+
+	helper.AssertErr("")
+	helper.AssertConfigValue("aura.output", "")
+	helper.AssertConfigValue("aura.auth-url", fmt.Sprintf("%s/oauth/token", helper.Server.URL))
+}
+
+func TestUnsetConfigWithInvalidConfigKey(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.ExecuteCommand("config unset invalid")
+
+	helper.AssertErr(`Error: invalid argument "invalid" for "aura-cli config unset"`)
+}
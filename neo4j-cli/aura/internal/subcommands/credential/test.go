@@ -0,0 +1,94 @@
+// Copyright (c) "Neo4j"
+// Neo4j Sweden AB [http://neo4j.com]
+
+package credential
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/neo4j/cli/common/clicfg"
+	"github.com/neo4j/cli/common/clicfg/credentials"
+	"github.com/neo4j/cli/common/clierr"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/api"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/output"
+	"github.com/spf13/cobra"
+)
+
+const (
+	ExitCodeInvalidCredential = 3
+	ExitCodeInvalidAuthUrl    = 4
+	ExitCodeInvalidBaseUrl    = 5
+	ExitCodeUnauthorized      = 6
+)
+
+func NewTestCmd(cfg *clicfg.Config) *cobra.Command {
+	return &cobra.Command{
+		Use:     "test [name]",
+		Aliases: []string{"whoami"},
+		Short:   "Checks that a credential can access the Aura API",
+		Long: `Requests a new access token for the credential from the configured auth URL and lists the tenants/projects the credential can see using the configured base URL. The current credential is tested when no name is given.
+
+Common misconfigurations are reported with their own exit code:
+  3  the client ID or client secret is invalid, expired, or revoked
+  4  the auth URL can't be reached or doesn't issue access tokens
+  5  the base URL can't be reached or doesn't serve the Aura API
+  6  the access token was not accepted by the Aura API`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var (
+				credential *credentials.AuraCredential
+				err        error
+			)
+			if len(args) == 1 {
+				credential, err = cfg.Credentials.Aura.Get(args[0])
+			} else {
+				credential, err = cfg.Credentials.Aura.GetCurrent()
+			}
+			if err != nil {
+				return err
+			}
+
+			cmd.SilenceUsage = true
+			grant, err := api.RequestToken(cfg, credential)
+			if err != nil {
+				var tokenErr *api.TokenRequestError
+				if errors.As(err, &tokenErr) && tokenErr.StatusCode == http.StatusUnauthorized {
+					return clierr.NewExitError(ExitCodeInvalidCredential, "the client ID or client secret of credential %s is invalid, expired, or revoked", credential.Name)
+				}
+				return clierr.NewExitError(ExitCodeInvalidAuthUrl, "cannot retrieve an access token from auth URL %s, please check the configured auth URL: %w", cfg.Aura.AuthUrl(), err)
+			}
+			tokenExpiry := time.Now().Add(time.Duration(grant.ExpiresIn) * time.Second)
+			credential = cfg.Credentials.Aura.UpdateAccessToken(credential, grant.AccessToken, grant.ExpiresIn)
+
+			resBody, statusCode, err := api.MakeRequest(cfg, "/tenants", &api.RequestConfig{
+				Method:     http.MethodGet,
+				Credential: credential,
+			})
+			if err != nil {
+				switch statusCode {
+				case 0, http.StatusNotFound:
+					return clierr.NewExitError(ExitCodeInvalidBaseUrl, "cannot list tenants from base URL %s: %w", cfg.Aura.BaseUrl(), err)
+				case http.StatusUnauthorized, http.StatusForbidden:
+					return clierr.NewExitError(ExitCodeUnauthorized, "%w", err)
+				default:
+					return err
+				}
+			}
+
+			tenants := api.ParseBody(resBody).AsArray()
+			result := map[string]any{
+				"name":         credential.Name,
+				"client_id":    credential.ClientId,
+				"auth_url":     cfg.Aura.AuthUrl(),
+				"base_url":     cfg.Aura.BaseUrl(),
+				"token_expiry": tokenExpiry.UTC().Format(time.RFC3339),
+				"tenants":      tenants,
+			}
+
+			output.PrintBodyMap(cmd, cfg, api.NewSingleValueResponseData(result), []string{"name", "client_id", "auth_url", "base_url", "token_expiry", "tenants"})
+			return nil
+		},
+	}
+}
@@ -0,0 +1,105 @@
+// Copyright (c) "Neo4j"
+// Neo4j Sweden AB [http://neo4j.com]
+
+package credential_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/test/testutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/tidwall/gjson"
+)
+
+func TestTestCredential(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	mockHandler := helper.NewRequestHandlerMock("/v1/tenants", http.StatusOK, `{
+		"data": [
+			{
+				"id": "YOUR_TENANT_ID",
+				"name": "Production"
+			}
+		]
+	}`)
+
+	helper.ExecuteCommand("credential test")
+
+	mockHandler.AssertCalledTimes(1)
+	mockHandler.AssertCalledWithMethod(http.MethodGet)
+
+	helper.AssertErr("")
+	out := helper.PrintOut()
+	assert.Equal(t, "test-cred", gjson.Get(out, "data.name").String())
+	assert.Equal(t, fmt.Sprintf("%s/oauth/token", helper.Server.URL), gjson.Get(out, "data.auth_url").String())
+	assert.Equal(t, helper.Server.URL, gjson.Get(out, "data.base_url").String())
+	assert.NotEmpty(t, gjson.Get(out, "data.token_expiry").String())
+	assert.Equal(t, `[{"id":"YOUR_TENANT_ID","name":"Production"}]`, gjson.Get(out, "@ugly|data.tenants").String())
+
+	helper.AssertCredentialsValue("aura.credentials.0.access-token", "<token>")
+}
+
+func TestTestNamedCredential(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.SetCredentialsValue("aura.credentials.1", map[string]string{"name": "other", "client-id": "otherclientid", "client-secret": "othersecret"})
+
+	helper.NewRequestHandlerMock("/v1/tenants", http.StatusOK, `{"data": []}`)
+
+	helper.ExecuteCommand("credential test other")
+
+	helper.AssertErr("")
+	out := helper.PrintOut()
+	assert.Equal(t, "other", gjson.Get(out, "data.name").String())
+	assert.Equal(t, "otherclientid", gjson.Get(out, "data.client_id").String())
+
+	helper.AssertCredentialsValue("aura.credentials.1.access-token", "<token>")
+	helper.AssertCredentialsValue("aura.default-credential", "test-cred")
+}
+
+func TestTestCredentialWithRevokedSecret(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	authServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		res.WriteHeader(http.StatusUnauthorized)
+		res.Write([]byte(`{"error": "invalid_client"}`))
+	}))
+	defer authServer.Close()
+
+	helper.SetConfigValue("aura.auth-url", authServer.URL)
+
+	helper.ExecuteCommand("credential test")
+
+	helper.AssertErr("Error: the client ID or client secret of credential test-cred is invalid, expired, or revoked")
+}
+
+func TestTestCredentialWithWrongAuthUrl(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	authServer := httptest.NewServer(http.NotFoundHandler())
+	defer authServer.Close()
+
+	helper.SetConfigValue("aura.auth-url", authServer.URL)
+
+	helper.ExecuteCommand("credential test")
+
+	helper.AssertErr(fmt.Sprintf("Error: cannot retrieve an access token from auth URL %s, please check the configured auth URL: response status code [404]", authServer.URL))
+}
+
+func TestTestCredentialWithWrongBaseUrl(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.NewRequestHandlerMock("/v1/tenants", http.StatusNotFound, `<html>not found</html>`)
+
+	helper.ExecuteCommand("credential test")
+
+	helper.AssertErr(fmt.Sprintf("Error: cannot list tenants from base URL %s: %s/v1/tenants was not found, please check the configured base URL", helper.Server.URL, helper.Server.URL))
+}
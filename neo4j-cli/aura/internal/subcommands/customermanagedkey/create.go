@@ -11,6 +11,7 @@ import (
 	"github.com/neo4j/cli/neo4j-cli/aura/internal/api"
 	"github.com/neo4j/cli/neo4j-cli/aura/internal/flags"
 	"github.com/neo4j/cli/neo4j-cli/aura/internal/output"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/subcommands/utils"
 	"github.com/spf13/cobra"
 )
 
@@ -61,13 +62,16 @@ Once the key has a status of ready you can use it for creating new instances by
 				"key_id":         keyId,
 			}
 
+			cmd.SilenceUsage = true
 			if tenantId == "" {
-				body["tenant_id"] = cfg.Aura.DefaultTenant()
-			} else {
-				body["tenant_id"] = tenantId
+				tenantId = cfg.Aura.DefaultTenant()
 			}
+			resolvedTenantId, err := utils.ResolveTenantId(cfg, tenantId)
+			if err != nil {
+				return err
+			}
+			body["tenant_id"] = resolvedTenantId
 
-			cmd.SilenceUsage = true
 			resBody, statusCode, err := api.MakeRequest(cfg, "/customer-managed-keys", &api.RequestConfig{
 				Method:   http.MethodPost,
 				PostBody: body,
@@ -9,20 +9,38 @@ import (
 
 	"github.com/neo4j/cli/common/clicfg"
 	"github.com/neo4j/cli/neo4j-cli/aura/internal/api"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/subcommands/utils"
 	"github.com/spf13/cobra"
 )
 
 func NewDeleteCmd(cfg *clicfg.Config) *cobra.Command {
-	return &cobra.Command{
+	var confirmation utils.ConfirmationFlags
+
+	cmd := &cobra.Command{
 		Use:   "delete <id>",
 		Short: "Deletes a customer managed key",
 		Long: `Deletes a Customer Managed Key from Aura.
 
-Note that you can only delete a Key if it is not being used by any instances, otherwise you will get an error with the reason field set to encryption-key-is-active.`,
+Note that you can only delete a Key if it is not being used by any instances, otherwise you will get an error with the reason field set to encryption-key-is-active.` + utils.ConfirmationHelp,
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			path := fmt.Sprintf("/customer-managed-keys/%s", args[0])
 			cmd.SilenceUsage = true
+			cmkId, err := utils.ResolveCustomerManagedKeyId(cfg, args[0])
+			if err != nil {
+				return err
+			}
+
+			path := fmt.Sprintf("/customer-managed-keys/%s", cmkId)
+			if err := confirmation.Confirm(cmd, cfg, utils.DestructiveOperation{
+				Kind:      "customer managed key",
+				Id:        cmkId,
+				Operation: "delete",
+				Question:  fmt.Sprintf("Delete customer managed key %s?", cmkId),
+				Name:      utils.ResourceName(cfg, path, api.AuraApiVersion1),
+			}); err != nil {
+				return err
+			}
+
 			_, statusCode, err := api.MakeRequest(cfg, path, &api.RequestConfig{
 				Method: http.MethodDelete,
 			})
@@ -38,4 +56,8 @@ Note that you can only delete a Key if it is not being used by any instances, ot
 			return nil
 		},
 	}
+
+	utils.AddConfirmationFlags(cmd, &confirmation)
+
+	return cmd
 }
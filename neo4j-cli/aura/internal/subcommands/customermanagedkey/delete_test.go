@@ -84,3 +84,23 @@ func TestDeleteCustomerManagedKeyError(t *testing.T) {
 		})
 	}
 }
+
+func TestDeleteProtectedCustomerManagedKey(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	cmkId := "8c764aed-8eb3-4a1c-92f6-e4ef0c7a6ed9"
+	helper.SetConfigValue("aura.protected-resources", []string{"^production-key$"})
+
+	helper.NewRequestHandlerMock(fmt.Sprintf("GET /v1/customer-managed-keys/%s", cmkId), http.StatusOK, fmt.Sprintf(`{"data": {"id": "%s", "name": "production-key"}}`, cmkId))
+	deleteMock := helper.NewRequestHandlerMock(fmt.Sprintf("DELETE /v1/customer-managed-keys/%s", cmkId), http.StatusNoContent, "")
+
+	helper.ExecuteCommand(fmt.Sprintf("customer-managed-key delete %s", cmkId))
+
+	deleteMock.AssertCalledTimes(0)
+	helper.AssertErr(fmt.Sprintf("Error: customer managed key %s is protected by the protected-resources config value, use --force to delete it", cmkId))
+
+	helper.ExecuteCommand(fmt.Sprintf("customer-managed-key delete %s --force", cmkId))
+
+	deleteMock.AssertCalledTimes(1)
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/neo4j/cli/common/clicfg"
 	"github.com/neo4j/cli/neo4j-cli/aura/internal/api"
 	"github.com/neo4j/cli/neo4j-cli/aura/internal/output"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/subcommands/utils"
 	"github.com/spf13/cobra"
 )
 
@@ -20,8 +21,13 @@ func NewGetCmd(cfg *clicfg.Config) *cobra.Command {
 		Long:  `This subcommand returns details about a specific Customer Managed Key.`,
 		Args:  cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			path := fmt.Sprintf("/customer-managed-keys/%s", args[0])
 			cmd.SilenceUsage = true
+			cmkId, err := utils.ResolveCustomerManagedKeyId(cfg, args[0])
+			if err != nil {
+				return err
+			}
+
+			path := fmt.Sprintf("/customer-managed-keys/%s", cmkId)
 			resBody, statusCode, err := api.MakeRequest(cfg, path, &api.RequestConfig{
 				Method: http.MethodGet,
 			})
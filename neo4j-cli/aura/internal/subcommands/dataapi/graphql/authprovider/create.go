@@ -11,6 +11,7 @@ import (
 	"github.com/neo4j/cli/neo4j-cli/aura/internal/api"
 	"github.com/neo4j/cli/neo4j-cli/aura/internal/flags"
 	"github.com/neo4j/cli/neo4j-cli/aura/internal/output"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/subcommands/utils"
 	"github.com/spf13/cobra"
 )
 
@@ -59,6 +60,16 @@ If you lose your API key, you will need to create a new Authentication provider.
 			return nil
 		},
 		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			resolvedInstanceId, err := utils.ResolveInstanceId(cfg, instanceId)
+			if err != nil {
+				return err
+			}
+			resolvedDataApiId, err := utils.ResolveGraphQLDataApiId(cfg, resolvedInstanceId, dataApiId)
+			if err != nil {
+				return err
+			}
+
 			body := map[string]any{
 				"type":    _type,
 				"name":    name,
@@ -69,8 +80,7 @@ If you lose your API key, you will need to create a new Authentication provider.
 				body["url"] = url
 			}
 
-			cmd.SilenceUsage = true
-			path := fmt.Sprintf("/instances/%s/data-apis/graphql/%s/auth-providers", instanceId, dataApiId)
+			path := fmt.Sprintf("/instances/%s/data-apis/graphql/%s/auth-providers", resolvedInstanceId, resolvedDataApiId)
 			resBody, statusCode, err := api.MakeRequest(cfg, path, &api.RequestConfig{
 				PostBody: body,
 				Method:   http.MethodPost,
@@ -92,7 +102,7 @@ If you lose your API key, you will need to create a new Authentication provider.
 
 				if await {
 					cmd.Println("Waiting for GraphQL Data API to be ready...")
-					pollResponse, err := api.PollGraphQLDataApi(cfg, instanceId, dataApiId, api.GraphQLDataApiStatusCreating)
+					pollResponse, err := api.PollGraphQLDataApi(cfg, resolvedInstanceId, resolvedDataApiId, api.GraphQLDataApiStatusCreating)
 					if err != nil {
 						return err
 					}
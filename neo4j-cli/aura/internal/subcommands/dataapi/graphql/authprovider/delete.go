@@ -10,6 +10,7 @@ import (
 	"github.com/neo4j/cli/common/clicfg"
 	"github.com/neo4j/cli/neo4j-cli/aura/internal/api"
 	"github.com/neo4j/cli/neo4j-cli/aura/internal/output"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/subcommands/utils"
 	"github.com/spf13/cobra"
 )
 
@@ -26,7 +27,16 @@ func NewDeleteCmd(cfg *clicfg.Config) *cobra.Command {
 		Args:  cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cmd.SilenceUsage = true
-			path := fmt.Sprintf("/instances/%s/data-apis/graphql/%s/auth-providers/%s", instanceId, dataApiId, args[0])
+			resolvedInstanceId, err := utils.ResolveInstanceId(cfg, instanceId)
+			if err != nil {
+				return err
+			}
+			resolvedDataApiId, err := utils.ResolveGraphQLDataApiId(cfg, resolvedInstanceId, dataApiId)
+			if err != nil {
+				return err
+			}
+
+			path := fmt.Sprintf("/instances/%s/data-apis/graphql/%s/auth-providers/%s", resolvedInstanceId, resolvedDataApiId, args[0])
 
 			resBody, statusCode, err := api.MakeRequest(cfg, path, &api.RequestConfig{
 				Method: http.MethodDelete,
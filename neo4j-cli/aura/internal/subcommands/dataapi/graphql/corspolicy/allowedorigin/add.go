@@ -12,6 +12,7 @@ import (
 	"github.com/neo4j/cli/common/clierr"
 	"github.com/neo4j/cli/neo4j-cli/aura/internal/api"
 	"github.com/neo4j/cli/neo4j-cli/aura/internal/output"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/subcommands/utils"
 	"github.com/spf13/cobra"
 )
 
@@ -38,6 +39,16 @@ Updating the CORS policy of a GraphQL Data API is an asynchronous operation. Use
 Adding a new allowed origin to the CORS policy of a GraphQL Data API allows browsers to make requests to the GraphQL Data API from a web app that is served from the specified origin.`,
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			instanceId, err := utils.ResolveInstanceId(cfg, instanceId)
+			if err != nil {
+				return err
+			}
+			dataApiId, err := utils.ResolveGraphQLDataApiId(cfg, instanceId, dataApiId)
+			if err != nil {
+				return err
+			}
+
 			newOrigin := args[0]
 
 			existingOrigins, err := getExistingOrigins(cfg, dataApiId, instanceId)
@@ -47,14 +58,12 @@ Adding a new allowed origin to the CORS policy of a GraphQL Data API allows brow
 
 			for _, origin := range existingOrigins {
 				if origin == newOrigin {
-					cmd.SilenceUsage = true
 					return clierr.NewUsageError("Origin \"%s\" already exists in allowed origins", newOrigin)
 				}
 			}
 
 			newOrigins := append(existingOrigins, newOrigin)
 
-			cmd.SilenceUsage = true
 			body := map[string]any{
 				"security": map[string]any{
 					"cors_policy": map[string]any{
@@ -11,6 +11,7 @@ import (
 	"github.com/neo4j/cli/common/clicfg"
 	"github.com/neo4j/cli/neo4j-cli/aura/internal/api"
 	"github.com/neo4j/cli/neo4j-cli/aura/internal/output"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/subcommands/utils"
 	"github.com/spf13/cobra"
 )
 
@@ -46,6 +47,12 @@ This command returns your GraphQL Data API ID, API key, and connection URL for y
 
 If you lose your API key, you will need to create a new Authentication provider. This will not result in any loss of data.`,
 		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			resolvedInstanceId, err := utils.ResolveInstanceId(cfg, instanceId)
+			if err != nil {
+				return err
+			}
+
 			body := map[string]any{
 				"name": name,
 				"aura_instance": map[string]string{
@@ -69,8 +76,7 @@ If you lose your API key, you will need to create a new Authentication provider.
 			}
 			body["type_definitions"] = typeDefsForBody
 
-			cmd.SilenceUsage = true
-			path := fmt.Sprintf("/instances/%s/data-apis/graphql", instanceId)
+			path := fmt.Sprintf("/instances/%s/data-apis/graphql", resolvedInstanceId)
 			resBody, statusCode, err := api.MakeRequest(cfg, path, &api.RequestConfig{
 				PostBody: body,
 				Method:   http.MethodPost,
@@ -95,7 +101,7 @@ If you lose your API key, you will need to create a new Authentication provider.
 						return err
 					}
 
-					pollResponse, err := api.PollGraphQLDataApi(cfg, instanceId, response.Data.Id, api.GraphQLDataApiStatusCreating)
+					pollResponse, err := api.PollGraphQLDataApi(cfg, resolvedInstanceId, response.Data.Id, api.GraphQLDataApiStatusCreating)
 					if err != nil {
 						return err
 					}
@@ -10,20 +10,40 @@ import (
 	"github.com/neo4j/cli/common/clicfg"
 	"github.com/neo4j/cli/neo4j-cli/aura/internal/api"
 	"github.com/neo4j/cli/neo4j-cli/aura/internal/output"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/subcommands/utils"
 	"github.com/spf13/cobra"
 )
 
 func NewDeleteCmd(cfg *clicfg.Config) *cobra.Command {
 	var instanceId string
+	var confirmation utils.ConfirmationFlags
 
 	cmd := &cobra.Command{
 		Use:   "delete <id>",
 		Short: "Delete a GraphQL Data API",
-		Long:  "Deletes a GraphQL Data API. This action can not be undone.",
+		Long:  "Deletes a GraphQL Data API. This action can not be undone." + utils.ConfirmationHelp,
 		Args:  cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cmd.SilenceUsage = true
-			path := fmt.Sprintf("/instances/%s/data-apis/graphql/%s", instanceId, args[0])
+			resolvedInstanceId, err := utils.ResolveInstanceId(cfg, instanceId)
+			if err != nil {
+				return err
+			}
+			dataApiId, err := utils.ResolveGraphQLDataApiId(cfg, resolvedInstanceId, args[0])
+			if err != nil {
+				return err
+			}
+
+			path := fmt.Sprintf("/instances/%s/data-apis/graphql/%s", resolvedInstanceId, dataApiId)
+			if err := confirmation.Confirm(cmd, cfg, utils.DestructiveOperation{
+				Kind:      "GraphQL Data API",
+				Id:        dataApiId,
+				Operation: "delete",
+				Question:  fmt.Sprintf("Delete GraphQL Data API %s of instance %s?", dataApiId, resolvedInstanceId),
+				Name:      utils.ResourceName(cfg, path, api.AuraApiVersion1),
+			}); err != nil {
+				return err
+			}
 
 			resBody, statusCode, err := api.MakeRequest(cfg, path, &api.RequestConfig{
 				Method: http.MethodDelete,
@@ -43,5 +63,7 @@ func NewDeleteCmd(cfg *clicfg.Config) *cobra.Command {
 	cmd.Flags().StringVar(&instanceId, "instance-id", "", "(required) The ID of the instance to delete the Data API for")
 	cmd.MarkFlagRequired("instance-id")
 
+	utils.AddConfirmationFlags(cmd, &confirmation)
+
 	return cmd
 }
@@ -110,3 +110,30 @@ func TestGetGraphQLDataApiIncludingGraphQLServerErrors(t *testing.T) {
 		]
 	}`)
 }
+
+func TestGetGraphQLDataApiByName(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.SetConfigValue("aura.beta-enabled", true)
+
+	helper.NewRequestHandlerMock("GET /v1beta5/instances", http.StatusOK, `{
+		"data": [
+			{"id": "2f49c2b3", "name": "Production", "tenant_id": "YOUR_TENANT_ID", "cloud_provider": "gcp"}
+		]
+	}`)
+	helper.NewRequestHandlerMock("GET /v1beta5/instances/2f49c2b3/data-apis/graphql", http.StatusOK, `{
+		"data": [
+			{"id": "afdb4e9d", "name": "friendly-name", "status": "ready"}
+		]
+	}`)
+	mockHandler := helper.NewRequestHandlerMock("GET /v1beta5/instances/2f49c2b3/data-apis/graphql/afdb4e9d", http.StatusOK, `{
+		"data": {"id": "afdb4e9d", "name": "friendly-name", "status": "ready"}
+	}`)
+
+	helper.ExecuteCommand("data-api graphql get --output json --instance-id name:Production friendly-name")
+
+	mockHandler.AssertCalledTimes(1)
+	helper.AssertErr("")
+	helper.AssertOutJson(`{"data": {"id": "afdb4e9d", "name": "friendly-name", "status": "ready"}}`)
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/neo4j/cli/common/clicfg"
 	"github.com/neo4j/cli/neo4j-cli/aura/internal/api"
 	"github.com/neo4j/cli/neo4j-cli/aura/internal/output"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/subcommands/utils"
 	"github.com/spf13/cobra"
 )
 
@@ -28,7 +29,16 @@ Pausing a GraphQL Data API is an asynchronous operation. Use the --await flag to
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cmd.SilenceUsage = true
-			path := fmt.Sprintf("/instances/%s/data-apis/graphql/%s/pause", instanceId, args[0])
+			resolvedInstanceId, err := utils.ResolveInstanceId(cfg, instanceId)
+			if err != nil {
+				return err
+			}
+			dataApiId, err := utils.ResolveGraphQLDataApiId(cfg, resolvedInstanceId, args[0])
+			if err != nil {
+				return err
+			}
+
+			path := fmt.Sprintf("/instances/%s/data-apis/graphql/%s/pause", resolvedInstanceId, dataApiId)
 
 			resBody, statusCode, err := api.MakeRequest(cfg, path, &api.RequestConfig{
 				Method: http.MethodPost,
@@ -43,7 +53,7 @@ Pausing a GraphQL Data API is an asynchronous operation. Use the --await flag to
 
 				if await {
 					cmd.Println("Waiting for GraphQL Data API to be paused...")
-					pollResponse, err := api.PollGraphQLDataApi(cfg, instanceId, args[0], api.GraphQLDataApiStatusPausing)
+					pollResponse, err := api.PollGraphQLDataApi(cfg, resolvedInstanceId, dataApiId, api.GraphQLDataApiStatusPausing)
 					if err != nil {
 						return err
 					}
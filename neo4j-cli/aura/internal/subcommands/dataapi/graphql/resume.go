@@ -10,6 +10,7 @@ import (
 	"github.com/neo4j/cli/common/clicfg"
 	"github.com/neo4j/cli/neo4j-cli/aura/internal/api"
 	"github.com/neo4j/cli/neo4j-cli/aura/internal/output"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/subcommands/utils"
 	"github.com/spf13/cobra"
 )
 
@@ -28,7 +29,16 @@ Resuming a GraphQL Data API is an asynchronous operation. Use the --await flag t
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cmd.SilenceUsage = true
-			path := fmt.Sprintf("/instances/%s/data-apis/graphql/%s/resume", instanceId, args[0])
+			resolvedInstanceId, err := utils.ResolveInstanceId(cfg, instanceId)
+			if err != nil {
+				return err
+			}
+			dataApiId, err := utils.ResolveGraphQLDataApiId(cfg, resolvedInstanceId, args[0])
+			if err != nil {
+				return err
+			}
+
+			path := fmt.Sprintf("/instances/%s/data-apis/graphql/%s/resume", resolvedInstanceId, dataApiId)
 
 			resBody, statusCode, err := api.MakeRequest(cfg, path, &api.RequestConfig{
 				Method: http.MethodPost,
@@ -43,7 +53,7 @@ Resuming a GraphQL Data API is an asynchronous operation. Use the --await flag t
 
 				if await {
 					cmd.Println("Waiting for GraphQL Data API to be resumed...")
-					pollResponse, err := api.PollGraphQLDataApi(cfg, instanceId, args[0], api.GraphQLDataApiStatusResuming)
+					pollResponse, err := api.PollGraphQLDataApi(cfg, resolvedInstanceId, dataApiId, api.GraphQLDataApiStatusResuming)
 					if err != nil {
 						return err
 					}
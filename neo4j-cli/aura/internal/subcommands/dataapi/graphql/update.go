@@ -10,6 +10,7 @@ import (
 	"github.com/neo4j/cli/common/clicfg"
 	"github.com/neo4j/cli/neo4j-cli/aura/internal/api"
 	"github.com/neo4j/cli/neo4j-cli/aura/internal/output"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/subcommands/utils"
 	"github.com/spf13/cobra"
 )
 
@@ -42,6 +43,16 @@ func NewUpdateCmd(cfg *clicfg.Config) *cobra.Command {
 Updating a GraphQL Data API is an asynchronous operation. Use the --await flag to wait for the GraphQL Data API to be ready again. Once the status transitions from "updating" to "ready" you may continue to use your GraphQL Data API.`,
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			resolvedInstanceId, err := utils.ResolveInstanceId(cfg, instanceId)
+			if err != nil {
+				return err
+			}
+			dataApiId, err := utils.ResolveGraphQLDataApiId(cfg, resolvedInstanceId, args[0])
+			if err != nil {
+				return err
+			}
+
 			body := map[string]any{}
 
 			if name != "" {
@@ -69,8 +80,7 @@ Updating a GraphQL Data API is an asynchronous operation. Use the --await flag t
 				body["aura_instance"] = auraInstance
 			}
 
-			cmd.SilenceUsage = true
-			path := fmt.Sprintf("/instances/%s/data-apis/graphql/%s", instanceId, args[0])
+			path := fmt.Sprintf("/instances/%s/data-apis/graphql/%s", resolvedInstanceId, dataApiId)
 
 			resBody, statusCode, err := api.MakeRequest(cfg, path, &api.RequestConfig{
 				Method:   http.MethodPatch,
@@ -86,7 +96,7 @@ Updating a GraphQL Data API is an asynchronous operation. Use the --await flag t
 
 				if await {
 					cmd.Println("Waiting for GraphQL Data API to be updated...")
-					pollResponse, err := api.PollGraphQLDataApi(cfg, instanceId, args[0], api.GraphQLDataApiStatusUpdating)
+					pollResponse, err := api.PollGraphQLDataApi(cfg, resolvedInstanceId, dataApiId, api.GraphQLDataApiStatusUpdating)
 					if err != nil {
 						return err
 					}
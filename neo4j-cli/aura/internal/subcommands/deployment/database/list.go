@@ -42,9 +42,14 @@ func NewListCmd(cfg *clicfg.Config) *cobra.Command {
 				return err
 			}
 
+			cmd.SilenceUsage = true
+			deploymentId, err := utils.ResolveDeploymentId(cfg, organizationId, projectId, deploymentId)
+			if err != nil {
+				return err
+			}
+
 			path := fmt.Sprintf("/organizations/%s/projects/%s/fleet-manager/deployments/%s/databases", organizationId, projectId, deploymentId)
 
-			cmd.SilenceUsage = true
 			resBody, statusCode, err := api.MakeRequest(cfg, path, &api.RequestConfig{
 				Method:  http.MethodGet,
 				Version: api.AuraApiVersion2,
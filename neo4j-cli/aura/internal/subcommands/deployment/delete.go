@@ -17,6 +17,7 @@ func NewDeleteCmd(cfg *clicfg.Config) *cobra.Command {
 	var (
 		organizationId string
 		projectId      string
+		confirmation   utils.ConfirmationFlags
 	)
 
 	const (
@@ -27,7 +28,7 @@ func NewDeleteCmd(cfg *clicfg.Config) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "delete",
 		Short: "Delete the given deployment",
-		Long:  "Deletes the given Fleet Manager deployment. This will only delete the deployment from Fleet Manager without affecting the actual running database. It is advised to disable Fleet Management for the database using `call fleetManagement.disable()`",
+		Long:  "Deletes the given Fleet Manager deployment. This will only delete the deployment from Fleet Manager without affecting the actual running database. It is advised to disable Fleet Management for the database using `call fleetManagement.disable()`" + utils.ConfirmationHelp,
 		Args:  cobra.ExactArgs(1),
 		PreRunE: func(cmd *cobra.Command, args []string) error {
 			return utils.SetProjectFlagsAsRequired(cfg, cmd)
@@ -38,10 +39,23 @@ func NewDeleteCmd(cfg *clicfg.Config) *cobra.Command {
 				return err
 			}
 
-			deploymentId := args[0]
+			cmd.SilenceUsage = true
+			deploymentId, err := utils.ResolveDeploymentId(cfg, organizationId, projectId, args[0])
+			if err != nil {
+				return err
+			}
+
 			path := fmt.Sprintf("/organizations/%s/projects/%s/fleet-manager/deployments/%s", organizationId, projectId, deploymentId)
+			if err := confirmation.Confirm(cmd, cfg, utils.DestructiveOperation{
+				Kind:      "deployment",
+				Id:        deploymentId,
+				Operation: "delete",
+				Question:  fmt.Sprintf("Delete deployment %s?", deploymentId),
+				Name:      utils.ResourceName(cfg, path, api.AuraApiVersion2),
+			}); err != nil {
+				return err
+			}
 
-			cmd.SilenceUsage = true
 			_, statusCode, err := api.MakeRequest(cfg, path, &api.RequestConfig{
 				Method:  http.MethodDelete,
 				Version: api.AuraApiVersion2,
@@ -60,5 +74,7 @@ func NewDeleteCmd(cfg *clicfg.Config) *cobra.Command {
 	cmd.Flags().StringVar(&organizationId, organizationIdFlag, "", "(required) Organization ID")
 	cmd.Flags().StringVar(&projectId, projectIdFlag, "", "(required) Project/tenant ID")
 
+	utils.AddConfirmationFlags(cmd, &confirmation)
+
 	return cmd
 }
@@ -39,10 +39,14 @@ func NewGetCmd(cfg *clicfg.Config) *cobra.Command {
 				return err
 			}
 
-			deploymentId := args[0]
+			cmd.SilenceUsage = true
+			deploymentId, err := utils.ResolveDeploymentId(cfg, organizationId, projectId, args[0])
+			if err != nil {
+				return err
+			}
+
 			path := fmt.Sprintf("/organizations/%s/projects/%s/fleet-manager/deployments/%s", organizationId, projectId, deploymentId)
 
-			cmd.SilenceUsage = true
 			resBody, statusCode, err := api.MakeRequest(cfg, path, &api.RequestConfig{
 				Method:  http.MethodGet,
 				Version: api.AuraApiVersion2,
@@ -294,3 +294,27 @@ func TestGetDeploymentWhenDeploymentDoesNotExist(t *testing.T) {
 
 	helper.AssertErr("Error: Access denied")
 }
+
+func TestGetDeploymentByName(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	organizationId := "81e4ae5c-171b-4700-b243-8d1dd34f7321"
+	projectId := "ef7faf53-fb7e-4994-8d0f-64ae56e91c42"
+
+	helper.NewRequestHandlerMock(fmt.Sprintf("GET /v2beta1/organizations/%s/projects/%s/fleet-manager/deployments", organizationId, projectId), http.StatusOK, `{
+		"data": [
+			{"id": "9a1e6181-7d0b-48a2-bc2b-4250c36b5cc2", "name": "Test Deployment"}
+		]
+	}`)
+	mockHandler := helper.NewRequestHandlerMock(fmt.Sprintf("GET /v2beta1/organizations/%s/projects/%s/fleet-manager/deployments/9a1e6181-7d0b-48a2-bc2b-4250c36b5cc2", organizationId, projectId), http.StatusOK, `{
+		"data": {"id": "9a1e6181-7d0b-48a2-bc2b-4250c36b5cc2", "name": "Test Deployment"}
+	}`)
+
+	helper.SetConfigValue("aura.beta-enabled", true)
+	helper.SetConfigValue("aura.output", "json")
+	helper.ExecuteCommand(fmt.Sprintf(`deployment get "name:Test Deployment" --organization-id=%s --project-id=%s`, organizationId, projectId))
+
+	mockHandler.AssertCalledTimes(1)
+	helper.AssertErr("")
+}
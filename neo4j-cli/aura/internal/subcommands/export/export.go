@@ -0,0 +1,30 @@
+// Copyright (c) "Neo4j"
+// Neo4j Sweden AB [http://neo4j.com]
+
+package export
+
+import (
+	"github.com/neo4j/cli/common/clicfg"
+	"github.com/spf13/cobra"
+)
+
+func NewCmd(cfg *clicfg.Config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export",
+		Short: "Exports existing Aura resources for use with other tools",
+		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+			cfg.Aura.BindBaseUrl(cmd.Flags().Lookup("base-url"))
+
+			cfg.Aura.BindAuthUrl(cmd.Flags().Lookup("auth-url"))
+
+			return nil
+		},
+	}
+
+	cmd.PersistentFlags().String("auth-url", "", "")
+	cmd.PersistentFlags().String("base-url", "", "")
+
+	cmd.AddCommand(NewTerraformCmd(cfg))
+
+	return cmd
+}
@@ -0,0 +1,355 @@
+// Copyright (c) "Neo4j"
+// Neo4j Sweden AB [http://neo4j.com]
+
+package export
+
+import (
+	"cmp"
+	"fmt"
+	"net/http"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/neo4j/cli/common/clicfg"
+	"github.com/neo4j/cli/common/clierr"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/api"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/subcommands/utils"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+)
+
+const (
+	resourceTypeInstance           = "aura_instance"
+	resourceTypeCustomerManagedKey = "aura_customer_managed_key"
+	resourceTypeGraphQLDataApi     = "aura_graphql_data_api"
+
+	// Maximum number of resources fetched at the same time
+	exportConcurrency = 4
+
+	terraformFile = "aura.tf"
+	importFile    = "import.sh"
+)
+
+// A resource block of the exported configuration and the ID terraform import adopts it with
+type terraformResource struct {
+	resourceType string
+	name         string
+	importId     string
+	comment      string
+	attributes   []hclAttribute
+}
+
+// An attribute of a resource block, the value is an HCL expression such as a quoted string or a reference
+type hclAttribute struct {
+	key   string
+	value string
+}
+
+// An attribute that is set from a field of the resource returned by the Aura API
+type attributeField struct {
+	attribute string
+	field     string
+}
+
+func (r terraformResource) address() string {
+	return r.resourceType + "." + r.name
+}
+
+func NewTerraformCmd(cfg *clicfg.Config) *cobra.Command {
+	var (
+		tenantId string
+		dir      string
+	)
+
+	const (
+		tenantIdFlag = "tenant-id"
+		dirFlag      = "dir"
+	)
+
+	cmd := &cobra.Command{
+		Use:   "terraform",
+		Short: "Exports instances, customer managed keys and Data APIs as Terraform configuration",
+		Long: `This subcommand reads the existing instances and customer managed keys, and the GraphQL Data APIs of the instances when beta is enabled, and returns them as Terraform resource blocks of the aura_instance, aura_customer_managed_key and aura_graphql_data_api resource types. Instances reference the customer managed key they are encrypted with, and Data APIs reference their instance.
+
+Along with the resource blocks, a terraform import command is returned for each resource, which adopts the existing resource into the Terraform state instead of creating it again. The resources of all tenants are exported unless --tenant-id is set.
+
+The configuration is printed with the import commands as comments at the end. With --dir, the configuration is written to aura.tf and the import commands to import.sh in the given directory instead, overwriting them if they exist.
+
+Secrets are not returned by the Aura API and are not exported: the username and password a Data API connects to its instance with need to be added to its resource block before it is applied.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			queryParams := map[string]string{}
+			if tenantId != "" {
+				resolvedTenantId, err := utils.ResolveTenantId(cfg, tenantId)
+				if err != nil {
+					return err
+				}
+				queryParams["tenantId"] = resolvedTenantId
+			}
+
+			resources, err := exportResources(cfg, queryParams)
+			if err != nil {
+				return err
+			}
+
+			if dir == "" {
+				cmd.Print(renderConfiguration(resources))
+				if len(resources) > 0 {
+					cmd.Println("\n# Adopt the existing resources into the Terraform state with:")
+					for _, command := range importCommands(resources) {
+						cmd.Printf("#   %s\n", command)
+					}
+				}
+				return nil
+			}
+
+			fs := cfg.Aura.Fs()
+			if err := fs.MkdirAll(dir, 0755); err != nil {
+				return err
+			}
+			if err := afero.WriteFile(fs, filepath.Join(dir, terraformFile), []byte(renderConfiguration(resources)), 0644); err != nil {
+				return err
+			}
+			script := "#!/bin/sh\nset -e\n\n" + strings.Join(importCommands(resources), "\n") + "\n"
+			if err := afero.WriteFile(fs, filepath.Join(dir, importFile), []byte(script), 0755); err != nil {
+				return err
+			}
+			cmd.Printf("Exported %d resources to %s, run %s to import them into the Terraform state\n", len(resources), filepath.Join(dir, terraformFile), filepath.Join(dir, importFile))
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&tenantId, tenantIdFlag, "", "Only exports the resources of this tenant/project")
+	cmd.Flags().StringVar(&dir, dirFlag, "", "Directory to write aura.tf and import.sh to, instead of printing the configuration")
+
+	return cmd
+}
+
+// Reads the customer managed keys, instances and, when beta is enabled, GraphQL Data APIs, and returns them as resource blocks in that order
+func exportResources(cfg *clicfg.Config, queryParams map[string]string) ([]terraformResource, error) {
+	keys, err := getDetails(cfg, "/customer-managed-keys", queryParams)
+	if err != nil {
+		return nil, err
+	}
+	instances, err := getDetails(cfg, "/instances", queryParams)
+	if err != nil {
+		return nil, err
+	}
+
+	names := map[string][]string{}
+	resources := []terraformResource{}
+
+	keyAddresses := map[string]string{}
+	for _, key := range keys {
+		resource := terraformResource{
+			resourceType: resourceTypeCustomerManagedKey,
+			name:         uniqueName(names, resourceTypeCustomerManagedKey, key),
+			importId:     fmt.Sprint(key["id"]),
+			attributes: stringAttributes(key, []attributeField{
+				{"name", "name"},
+				{"tenant_id", "tenant_id"},
+				{"cloud_provider", "cloud_provider"},
+				{"region", "region"},
+				{"instance_type", "type"},
+				{"key_id", "key_id"},
+			}),
+		}
+		keyAddresses[resource.importId] = resource.address()
+		resources = append(resources, resource)
+	}
+
+	instanceAddresses := map[string]string{}
+	for _, instance := range instances {
+		attributes := stringAttributes(instance, []attributeField{
+			{"name", "name"},
+			{"tenant_id", "tenant_id"},
+			{"type", "type"},
+			{"cloud_provider", "cloud_provider"},
+			{"region", "region"},
+			{"memory", "memory"},
+			{"version", "version"},
+		})
+		for _, key := range []string{"vector_optimized", "graph_analytics_plugin"} {
+			if value, ok := instance[key].(bool); ok {
+				attributes = append(attributes, hclAttribute{key, fmt.Sprint(value)})
+			}
+		}
+		if keyId, ok := instance["customer_managed_key_id"].(string); ok && keyId != "" {
+			value := hclString(keyId)
+			if address, ok := keyAddresses[keyId]; ok {
+				value = address + ".id"
+			}
+			attributes = append(attributes, hclAttribute{"customer_managed_key_id", value})
+		}
+
+		resource := terraformResource{
+			resourceType: resourceTypeInstance,
+			name:         uniqueName(names, resourceTypeInstance, instance),
+			importId:     fmt.Sprint(instance["id"]),
+			attributes:   attributes,
+		}
+		instanceAddresses[resource.importId] = resource.address()
+		resources = append(resources, resource)
+	}
+
+	// Data APIs are only available with the beta versions of the Aura API
+	if !cfg.Aura.AuraBetaEnabled() {
+		return resources, nil
+	}
+	for _, instance := range instances {
+		instanceId := fmt.Sprint(instance["id"])
+		dataApis, err := getDetails(cfg, fmt.Sprintf("/instances/%s/data-apis/graphql", instanceId), nil)
+		if err != nil {
+			return nil, err
+		}
+		for _, dataApi := range dataApis {
+			attributes := []hclAttribute{{"instance_id", instanceAddresses[instanceId] + ".id"}}
+			attributes = append(attributes, stringAttributes(dataApi, []attributeField{
+				{"name", "name"},
+				{"type_definitions", "type_definitions"},
+			})...)
+			resources = append(resources, terraformResource{
+				resourceType: resourceTypeGraphQLDataApi,
+				name:         uniqueName(names, resourceTypeGraphQLDataApi, dataApi),
+				importId:     fmt.Sprintf("%s/%s", instanceId, dataApi["id"]),
+				comment:      "The username and password of the instance are not exported, add them before applying",
+				attributes:   attributes,
+			})
+		}
+	}
+
+	return resources, nil
+}
+
+// Lists the resources of the path and gets the details of each of them, sorted by tenant and name
+func getDetails(cfg *clicfg.Config, path string, queryParams map[string]string) ([]map[string]any, error) {
+	resBody, _, err := api.MakeRequest(cfg, path, &api.RequestConfig{
+		Method:      http.MethodGet,
+		QueryParams: queryParams,
+	})
+	if err != nil {
+		return nil, err
+	}
+	list := api.ParseBody(resBody).AsArray()
+
+	details := make([]map[string]any, len(list))
+	errs := make([]error, len(list))
+	utils.RunConcurrently(exportConcurrency, len(list), func(i int) {
+		resBody, _, err := api.MakeRequest(cfg, fmt.Sprintf("%s/%s", path, list[i]["id"]), &api.RequestConfig{
+			Method: http.MethodGet,
+		})
+		if err != nil {
+			errs[i] = err
+			return
+		}
+		details[i], errs[i] = api.ParseBody(resBody).GetSingleOrError()
+	})
+	for i, err := range errs {
+		if err != nil {
+			return nil, clierr.NewUpstreamError("cannot export %s: %w", list[i]["id"], err)
+		}
+		// Details do not always repeat the fields of the list, such as the tenant
+		for key, value := range list[i] {
+			if _, ok := details[i][key]; !ok {
+				details[i][key] = value
+			}
+		}
+	}
+
+	slices.SortStableFunc(details, func(a, b map[string]any) int {
+		return cmp.Or(
+			strings.Compare(fmt.Sprint(a["tenant_id"]), fmt.Sprint(b["tenant_id"])),
+			strings.Compare(fmt.Sprint(a["name"]), fmt.Sprint(b["name"])),
+		)
+	})
+	return details, nil
+}
+
+// Returns the attributes of the string fields the resource has set
+func stringAttributes(resource map[string]any, fields []attributeField) []hclAttribute {
+	attributes := []hclAttribute{}
+	for _, field := range fields {
+		value, ok := resource[field.field].(string)
+		if !ok || value == "" {
+			continue
+		}
+		attributes = append(attributes, hclAttribute{field.attribute, hclString(value)})
+	}
+	return attributes
+}
+
+var invalidNameCharacters = regexp.MustCompile(`[^a-z0-9_-]+`)
+
+// Derives a Terraform resource name from the name of the resource, which is unique among the resources of the same type
+func uniqueName(names map[string][]string, resourceType string, resource map[string]any) string {
+	name := strings.Trim(invalidNameCharacters.ReplaceAllString(strings.ToLower(fmt.Sprint(resource["name"])), "_"), "_-")
+	if name == "" || !(name[0] >= 'a' && name[0] <= 'z') {
+		name = strings.TrimSuffix(strings.TrimPrefix(resourceType, "aura_")+"_"+name, "_")
+	}
+
+	unique := name
+	for i := 2; slices.Contains(names[resourceType], unique); i++ {
+		unique = fmt.Sprintf("%s_%d", name, i)
+	}
+	names[resourceType] = append(names[resourceType], unique)
+	return unique
+}
+
+func renderConfiguration(resources []terraformResource) string {
+	var b strings.Builder
+	b.WriteString("# Exported from Aura by aura-cli export terraform\n")
+	for _, resource := range resources {
+		fmt.Fprintf(&b, "\nresource %s %s {\n", hclString(resource.resourceType), hclString(resource.name))
+		if resource.comment != "" {
+			fmt.Fprintf(&b, "  # %s\n", resource.comment)
+		}
+		width := 0
+		for _, attribute := range resource.attributes {
+			width = max(width, len(attribute.key))
+		}
+		for _, attribute := range resource.attributes {
+			fmt.Fprintf(&b, "  %-*s = %s\n", width, attribute.key, attribute.value)
+		}
+		b.WriteString("}\n")
+	}
+	return b.String()
+}
+
+func importCommands(resources []terraformResource) []string {
+	commands := []string{}
+	for _, resource := range resources {
+		commands = append(commands, fmt.Sprintf("terraform import %s %s", resource.address(), resource.importId))
+	}
+	return commands
+}
+
+// Quotes a string as an HCL string literal, escaping template sequences so that the value is used as is
+func hclString(value string) string {
+	var b strings.Builder
+	b.WriteByte('"')
+	for i, r := range value {
+		switch {
+		case r == '"' || r == '\\':
+			b.WriteByte('\\')
+			b.WriteRune(r)
+		case r == '\n':
+			b.WriteString(`\n`)
+		case r == '\r':
+			b.WriteString(`\r`)
+		case r == '\t':
+			b.WriteString(`\t`)
+		case r < 0x20:
+			fmt.Fprintf(&b, `\u%04x`, r)
+		case (r == '$' || r == '%') && strings.HasPrefix(value[i+1:], "{"):
+			b.WriteRune(r)
+			b.WriteRune(r)
+		default:
+			b.WriteRune(r)
+		}
+	}
+	b.WriteByte('"')
+	return b.String()
+}
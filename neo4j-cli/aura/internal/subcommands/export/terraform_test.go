@@ -0,0 +1,127 @@
+// Copyright (c) "Neo4j"
+// Neo4j Sweden AB [http://neo4j.com]
+
+package export_test
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/test/testutils"
+	"github.com/stretchr/testify/assert"
+)
+
+func mockExport(helper *testutils.AuraTestHelper, version string) {
+	helper.NewRequestHandlerMock("GET /"+version+"/customer-managed-keys", http.StatusOK, `{"data": [{"id": "cmk1", "name": "Orders Key", "tenant_id": "YOUR_TENANT_ID"}]}`)
+	helper.NewRequestHandlerMock("GET /"+version+"/customer-managed-keys/cmk1", http.StatusOK, `{"data": {"id": "cmk1", "name": "Orders Key", "tenant_id": "YOUR_TENANT_ID", "status": "ready", "cloud_provider": "aws", "region": "us-east-1", "type": "enterprise-db", "key_id": "arn:aws:kms:us-east-1:123456789:key/abc"}}`)
+	helper.NewRequestHandlerMock("GET /"+version+"/instances", http.StatusOK, `{"data": [
+		{"id": "db1d1234", "name": "orders", "tenant_id": "YOUR_TENANT_ID", "cloud_provider": "aws"},
+		{"id": "db2d1234", "name": "orders", "tenant_id": "YOUR_TENANT_ID", "cloud_provider": "aws"}
+	]}`)
+	helper.NewRequestHandlerMock("GET /"+version+"/instances/db1d1234", http.StatusOK, `{"data": {"id": "db1d1234", "name": "orders", "tenant_id": "YOUR_TENANT_ID", "status": "running", "type": "enterprise-db", "cloud_provider": "aws", "region": "us-east-1", "memory": "8GB", "storage": "16GB", "customer_managed_key_id": "cmk1", "vector_optimized": false, "graph_analytics_plugin": true}}`)
+	helper.NewRequestHandlerMock("GET /"+version+"/instances/db2d1234", http.StatusOK, `{"data": {"id": "db2d1234", "name": "orders", "tenant_id": "YOUR_TENANT_ID", "status": "paused", "type": "professional-db", "cloud_provider": "aws", "region": "us-east-1", "memory": "2GB"}}`)
+}
+
+func TestExportTerraform(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	mockExport(&helper, "v1")
+
+	helper.ExecuteCommand("export terraform")
+
+	helper.AssertErr("")
+	helper.AssertOut(`# Exported from Aura by aura-cli export terraform
+
+resource "aura_customer_managed_key" "orders_key" {
+  name           = "Orders Key"
+  tenant_id      = "YOUR_TENANT_ID"
+  cloud_provider = "aws"
+  region         = "us-east-1"
+  instance_type  = "enterprise-db"
+  key_id         = "arn:aws:kms:us-east-1:123456789:key/abc"
+}
+
+resource "aura_instance" "orders" {
+  name                    = "orders"
+  tenant_id               = "YOUR_TENANT_ID"
+  type                    = "enterprise-db"
+  cloud_provider          = "aws"
+  region                  = "us-east-1"
+  memory                  = "8GB"
+  vector_optimized        = false
+  graph_analytics_plugin  = true
+  customer_managed_key_id = aura_customer_managed_key.orders_key.id
+}
+
+resource "aura_instance" "orders_2" {
+  name           = "orders"
+  tenant_id      = "YOUR_TENANT_ID"
+  type           = "professional-db"
+  cloud_provider = "aws"
+  region         = "us-east-1"
+  memory         = "2GB"
+}
+
+# Adopt the existing resources into the Terraform state with:
+#   terraform import aura_customer_managed_key.orders_key cmk1
+#   terraform import aura_instance.orders db1d1234
+#   terraform import aura_instance.orders_2 db2d1234`)
+}
+
+func TestExportTerraformWithDataApisToDir(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.SetConfigValue("aura.beta-enabled", true)
+	mockExport(&helper, "v1beta5")
+	helper.NewRequestHandlerMock("GET /v1beta5/instances/db1d1234/data-apis/graphql", http.StatusOK, `{"data": [{"id": "api1", "name": "1st API", "status": "ready", "url": "https://api1.example.com/graphql"}]}`)
+	helper.NewRequestHandlerMock("GET /v1beta5/instances/db1d1234/data-apis/graphql/api1", http.StatusOK, `{"data": {"id": "api1", "name": "1st API", "status": "ready", "type_definitions": "dHlwZSBNb3ZpZSB7IHRpdGxlOiBTdHJpbmcgfQ==", "url": "https://api1.example.com/graphql"}}`)
+	helper.NewRequestHandlerMock("GET /v1beta5/instances/db2d1234/data-apis/graphql", http.StatusOK, `{"data": []}`)
+
+	helper.ExecuteCommand("export terraform --dir infra")
+
+	helper.AssertErr("")
+	helper.AssertOut("Exported 4 resources to infra/aura.tf, run infra/import.sh to import them into the Terraform state")
+	configuration := helper.ReadFile("infra/aura.tf")
+	expected := `resource "aura_graphql_data_api" "graphql_data_api_1st_api" {
+  # The username and password of the instance are not exported, add them before applying
+  instance_id      = aura_instance.orders.id
+  name             = "1st API"
+  type_definitions = "dHlwZSBNb3ZpZSB7IHRpdGxlOiBTdHJpbmcgfQ=="
+}
+`
+	assert.True(t, strings.HasSuffix(configuration, expected), configuration)
+	assert.Equal(t, `#!/bin/sh
+set -e
+
+terraform import aura_customer_managed_key.orders_key cmk1
+terraform import aura_instance.orders db1d1234
+terraform import aura_instance.orders_2 db2d1234
+terraform import aura_graphql_data_api.graphql_data_api_1st_api db1d1234/api1
+`, helper.ReadFile("infra/import.sh"))
+}
+
+func TestExportTerraformEscapesTemplateSequences(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.NewRequestHandlerMock("GET /v1/customer-managed-keys", http.StatusOK, `{"data": []}`)
+	instancesMock := helper.NewRequestHandlerMock("GET /v1/instances", http.StatusOK, `{"data": [{"id": "db1d1234", "name": "a \"${b}\" %{c}", "tenant_id": "YOUR_TENANT_ID"}]}`)
+	helper.NewRequestHandlerMock("GET /v1/instances/db1d1234", http.StatusOK, `{"data": {"id": "db1d1234", "name": "a \"${b}\" %{c}", "tenant_id": "YOUR_TENANT_ID"}}`)
+
+	helper.ExecuteCommand("export terraform --tenant-id YOUR_TENANT_ID")
+
+	helper.AssertErr("")
+	instancesMock.AssertCalledWithQueryParam("tenantId", "YOUR_TENANT_ID")
+	helper.AssertOut(`# Exported from Aura by aura-cli export terraform
+
+resource "aura_instance" "a_b_c" {
+  name      = "a \"$${b}\" %%{c}"
+  tenant_id = "YOUR_TENANT_ID"
+}
+
+# Adopt the existing resources into the Terraform state with:
+#   terraform import aura_instance.a_b_c db1d1234`)
+}
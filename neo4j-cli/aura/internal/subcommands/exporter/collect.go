@@ -0,0 +1,225 @@
+// Copyright (c) "Neo4j"
+// Neo4j Sweden AB [http://neo4j.com]
+
+package exporter
+
+import (
+	"fmt"
+	"maps"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/neo4j/cli/common/clicfg"
+	"github.com/neo4j/cli/common/clicfg/credentials"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/api"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/prometheus"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/subcommands/utils"
+)
+
+// The result of a collection: the gauges to expose and the requests that failed
+type collection struct {
+	gauges []*prometheus.Gauge
+	errors []error
+}
+
+type collector struct {
+	cfg         *clicfg.Config
+	concurrency int
+	// The Fleet Manager servers are only collected when both are set
+	organizationId string
+	projectId      string
+
+	// All requests of the exporter share the access token of the credential
+	credential *credentials.AuraCredential
+
+	mu     sync.Mutex
+	errors []error
+}
+
+func (c *collector) fail(err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.errors = append(c.errors, err)
+}
+
+func (c *collector) request(path string, requestConfig api.RequestConfig) ([]byte, error) {
+	requestConfig.Method = http.MethodGet
+	requestConfig.Credential = c.credential
+	resBody, _, err := api.MakeRequest(c.cfg, path, &requestConfig)
+	return resBody, err
+}
+
+func (c *collector) get(path string, requestConfig api.RequestConfig) ([]map[string]any, error) {
+	resBody, err := c.request(path, requestConfig)
+	if err != nil {
+		return nil, err
+	}
+	return api.ParseBody(resBody).AsArray(), nil
+}
+
+// Collects the state of the instances, their snapshots, the Graph Analytics sessions and the Fleet Manager servers.
+// Failed requests are returned with the gauges instead of stopping the collection, whose gauges then lack the failed resources.
+func (c *collector) collect(now time.Time) *collection {
+	c.errors = nil
+
+	instanceStatus := prometheus.NewGauge("aura_instance_status", "Status of the instance, 1 for its current status")
+	instanceMemory := prometheus.NewGauge("aura_instance_memory_bytes", "Memory of the instance in bytes")
+	snapshotCompleted := prometheus.NewGauge("aura_snapshot_last_completed_timestamp", "Unix time of the latest completed snapshot of the instance of the current or the previous day (UTC)")
+	sessionStatus := prometheus.NewGauge("aura_graph_analytics_session_status", "Status of the Graph Analytics session, 1 for its current status")
+	sessionExpiry := prometheus.NewGauge("aura_graph_analytics_session_expiry_timestamp", "Unix time at which the Graph Analytics session expires")
+	serverStatus := prometheus.NewGauge("aura_fleet_server_status", "Status of the Fleet Manager server, 1 for its current status")
+	serverPing := prometheus.NewGauge("aura_fleet_server_last_ping_seconds", "Seconds since the last ping of the Fleet Manager server at the time of the collection")
+
+	instances, err := c.get("/instances", api.RequestConfig{})
+	if err != nil {
+		c.fail(err)
+	}
+	details := make([]map[string]any, len(instances))
+	latestSnapshots := make([]time.Time, len(instances))
+	utils.RunConcurrently(c.concurrency, len(instances)*2, func(i int) {
+		index := i / 2
+		instanceId := fmt.Sprint(instances[index]["id"])
+		if i%2 == 0 {
+			resBody, err := c.request(fmt.Sprintf("/instances/%s", instanceId), api.RequestConfig{})
+			if err == nil {
+				details[index], err = api.ParseBody(resBody).GetSingleOrError()
+			}
+			if err != nil {
+				c.fail(err)
+			}
+			return
+		}
+		latest, err := c.latestCompletedSnapshot(instanceId, now)
+		if err != nil {
+			c.fail(err)
+			return
+		}
+		latestSnapshots[index] = latest
+	})
+	for i, instance := range instances {
+		labels := map[string]string{
+			"id":     fmt.Sprint(instance["id"]),
+			"name":   fmt.Sprint(instance["name"]),
+			"tenant": fmt.Sprint(instance["tenant_id"]),
+		}
+		if details[i] != nil {
+			instanceStatus.Add(1, withLabel(labels, "status", fmt.Sprint(details[i]["status"])))
+			if memory, ok := memoryBytes(details[i]["memory"]); ok {
+				instanceMemory.Add(memory, labels)
+			}
+		}
+		if !latestSnapshots[i].IsZero() {
+			snapshotCompleted.Add(float64(latestSnapshots[i].Unix()), labels)
+		}
+	}
+
+	sessions, err := c.get("/graph-analytics/sessions", api.RequestConfig{})
+	if err != nil {
+		c.fail(err)
+	}
+	for _, session := range sessions {
+		labels := map[string]string{
+			"id":          fmt.Sprint(session["id"]),
+			"name":        fmt.Sprint(session["name"]),
+			"tenant":      fmt.Sprint(session["tenant_id"]),
+			"instance_id": stringOrEmpty(session["instance_id"]),
+		}
+		sessionStatus.Add(1, withLabel(labels, "status", fmt.Sprint(session["status"])))
+		if expiry, err := time.Parse(time.RFC3339, stringOrEmpty(session["expiry_date"])); err == nil {
+			sessionExpiry.Add(float64(expiry.Unix()), labels)
+		}
+	}
+
+	gauges := []*prometheus.Gauge{instanceStatus, instanceMemory, snapshotCompleted, sessionStatus, sessionExpiry}
+	if c.organizationId != "" && c.projectId != "" {
+		c.collectServers(now, serverStatus, serverPing)
+		gauges = append(gauges, serverStatus, serverPing)
+	}
+	return &collection{gauges: gauges, errors: c.errors}
+}
+
+// Returns the time of the latest completed snapshot of the current day, or else of the previous day, zero when there is none
+func (c *collector) latestCompletedSnapshot(instanceId string, now time.Time) (time.Time, error) {
+	for _, day := range []time.Time{now.UTC(), now.UTC().AddDate(0, 0, -1)} {
+		snapshots, err := c.get(fmt.Sprintf("/instances/%s/snapshots", instanceId), api.RequestConfig{
+			QueryParams: map[string]string{"date": day.Format(time.DateOnly)},
+		})
+		if err != nil {
+			return time.Time{}, err
+		}
+		latest := time.Time{}
+		for _, snapshot := range snapshots {
+			if snapshot["status"] != api.SnapshotStatusCompleted {
+				continue
+			}
+			timestamp, err := time.Parse(time.RFC3339, fmt.Sprint(snapshot["timestamp"]))
+			if err == nil && timestamp.After(latest) {
+				latest = timestamp
+			}
+		}
+		if !latest.IsZero() {
+			return latest, nil
+		}
+	}
+	return time.Time{}, nil
+}
+
+// Adds the status and the time since the last ping of the servers of all Fleet Manager deployments of the project
+func (c *collector) collectServers(now time.Time, serverStatus *prometheus.Gauge, serverPing *prometheus.Gauge) {
+	deploymentsPath := fmt.Sprintf("/organizations/%s/projects/%s/fleet-manager/deployments", c.organizationId, c.projectId)
+	deployments, err := c.get(deploymentsPath, api.RequestConfig{Version: api.AuraApiVersion2})
+	if err != nil {
+		c.fail(err)
+		return
+	}
+
+	servers := make([][]map[string]any, len(deployments))
+	utils.RunConcurrently(c.concurrency, len(deployments), func(i int) {
+		list, err := c.get(fmt.Sprintf("%s/%s/servers", deploymentsPath, deployments[i]["id"]), api.RequestConfig{Version: api.AuraApiVersion2})
+		if err != nil {
+			c.fail(err)
+			return
+		}
+		servers[i] = list
+	})
+	for i, deployment := range deployments {
+		for _, server := range servers[i] {
+			labels := map[string]string{
+				"id":              fmt.Sprint(server["id"]),
+				"name":            fmt.Sprint(server["name"]),
+				"deployment_id":   fmt.Sprint(deployment["id"]),
+				"deployment_name": fmt.Sprint(deployment["name"]),
+			}
+			serverStatus.Add(1, withLabel(labels, "status", fmt.Sprint(server["status"])))
+			if lastPing, err := time.Parse(time.RFC3339, stringOrEmpty(server["last_ping"])); err == nil {
+				serverPing.Add(now.Sub(lastPing).Seconds(), labels)
+			}
+		}
+	}
+}
+
+func withLabel(labels map[string]string, name string, value string) map[string]string {
+	result := maps.Clone(labels)
+	result[name] = value
+	return result
+}
+
+// Returns the value of a string field that may be null
+func stringOrEmpty(value any) string {
+	if s, ok := value.(string); ok {
+		return s
+	}
+	return ""
+}
+
+// Returns the bytes of a memory value such as 8GB
+func memoryBytes(memory any) (float64, bool) {
+	value, err := strconv.ParseFloat(strings.TrimSuffix(fmt.Sprint(memory), "GB"), 64)
+	if err != nil {
+		return 0, false
+	}
+	return value * (1 << 30), true
+}
@@ -0,0 +1,213 @@
+// Copyright (c) "Neo4j"
+// Neo4j Sweden AB [http://neo4j.com]
+
+package exporter
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"log/slog"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/neo4j/cli/common/clicfg"
+	"github.com/neo4j/cli/common/clierr"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/flags"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/prometheus"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/subcommands/utils"
+	"github.com/spf13/cobra"
+)
+
+func NewCmd(cfg *clicfg.Config) *cobra.Command {
+	var (
+		listen         string
+		interval       time.Duration
+		concurrency    int
+		once           bool
+		organizationId string
+		projectId      string
+		logFormat      flags.LogFormat = "text"
+	)
+
+	const (
+		listenFlag         = "listen"
+		intervalFlag       = "interval"
+		concurrencyFlag    = "concurrency"
+		onceFlag           = "once"
+		organizationIdFlag = "organization-id"
+		projectIdFlag      = "project-id"
+		logFormatFlag      = "log-format"
+	)
+
+	cmd := &cobra.Command{
+		Use:   "exporter",
+		Short: "Exposes the state of Aura resources as Prometheus metrics",
+		Long: `This command collects the state of the instances, their snapshots and the Graph Analytics sessions every --interval and exposes it as Prometheus gauges on http://<listen>/metrics:
+
+  aura_instance_status{id,name,tenant,status}
+      1 for the current status of the instance
+  aura_instance_memory_bytes{id,name,tenant}
+      memory of the instance
+  aura_snapshot_last_completed_timestamp{id,name,tenant}
+      Unix time of the latest completed snapshot of the current or the previous day (UTC)
+  aura_graph_analytics_session_status{id,name,tenant,instance_id,status}
+      1 for the current status of the session
+  aura_graph_analytics_session_expiry_timestamp{id,name,tenant,instance_id}
+      Unix time at which the session expires
+  aura_exporter_collection_errors
+      number of requests that failed in the latest collection
+  aura_exporter_last_collection_timestamp
+      Unix time of the latest collection
+
+When beta is enabled and an organization and project are set, with --organization-id and --project-id or as the default project, the servers of its Fleet Manager deployments are exposed too:
+
+  aura_fleet_server_status{id,name,deployment_id,deployment_name,status}
+      1 for the current status of the server
+  aura_fleet_server_last_ping_seconds{id,name,deployment_id,deployment_name}
+      seconds since the last ping of the server
+
+Scrapes are served from the latest collection, so Prometheus can scrape as often as it likes without adding requests to the Aura API. All requests share the access token of the credential, which is only requested again when it expires. A failed request does not stop the exporter, the resources it would have returned are left out of that collection and the error is logged to stderr.
+
+With --once the metrics are collected once and printed to stdout, for example for the textfile collector of the node exporter, and the command exits with a non-zero status when any request failed.`,
+		Args: cobra.NoArgs,
+		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+			cfg.Aura.BindBaseUrl(cmd.Flags().Lookup("base-url"))
+
+			cfg.Aura.BindAuthUrl(cmd.Flags().Lookup("auth-url"))
+
+			return nil
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if interval <= 0 {
+				return clierr.NewUsageError(`invalid argument "%s" for "--%s" flag: must be greater than 0`, interval, intervalFlag)
+			}
+			if concurrency < 1 {
+				return clierr.NewUsageError(`invalid argument "%d" for "--%s" flag: must be at least 1`, concurrency, concurrencyFlag)
+			}
+
+			credential, err := cfg.Credentials.Aura.GetCurrent()
+			if err != nil {
+				return err
+			}
+			c := &collector{cfg: cfg, concurrency: concurrency, credential: credential}
+			if cfg.Aura.AuraBetaEnabled() {
+				c.organizationId, c.projectId, err = utils.SetProjetDefaults(cfg, organizationId, projectId)
+				if err != nil {
+					return err
+				}
+			}
+
+			cmd.SilenceUsage = true
+			var handler slog.Handler
+			if logFormat == "json" {
+				handler = slog.NewJSONHandler(cmd.ErrOrStderr(), nil)
+			} else {
+				handler = slog.NewTextHandler(cmd.ErrOrStderr(), nil)
+			}
+			logger := slog.New(handler)
+
+			if once {
+				metrics, failed := collectMetrics(c, logger)
+				cmd.Print(string(metrics))
+				if failed > 0 {
+					return clierr.NewUpstreamError("the collection is incomplete, %d requests failed", failed)
+				}
+				return nil
+			}
+
+			// Listening before the first collection reports an unavailable address right away
+			listener, err := net.Listen("tcp", listen)
+			if err != nil {
+				return clierr.NewUsageError("cannot listen on %s: %s", listen, err)
+			}
+			ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt, syscall.SIGTERM)
+			defer stop()
+			return serve(ctx, c, logger, listener, interval)
+		},
+	}
+
+	cmd.PersistentFlags().String("auth-url", "", "")
+	cmd.PersistentFlags().String("base-url", "", "")
+
+	cmd.Flags().StringVar(&listen, listenFlag, ":9100", "The address to serve the metrics on")
+	cmd.Flags().DurationVar(&interval, intervalFlag, time.Minute, "How often the metrics are collected from the Aura API")
+	cmd.Flags().IntVar(&concurrency, concurrencyFlag, 4, "Maximum number of requests made at the same time")
+	cmd.Flags().BoolVar(&once, onceFlag, false, "Collects the metrics once, prints them and exits")
+	cmd.Flags().StringVar(&organizationId, organizationIdFlag, "", "Organization ID of the Fleet Manager deployments, defaults to the one of the default project")
+	cmd.Flags().StringVar(&projectId, projectIdFlag, "", "Project/tenant ID of the Fleet Manager deployments, defaults to the one of the default project")
+	cmd.Flags().Var(&logFormat, logFormatFlag, "The format of the log, text or json")
+
+	return cmd
+}
+
+// Collects the metrics in the Prometheus text exposition format and returns them with the number of failed requests, which are logged
+func collectMetrics(c *collector, logger *slog.Logger) ([]byte, int) {
+	start := time.Now()
+	result := c.collect(start)
+	for _, err := range result.errors {
+		logger.Warn("request failed", "error", err.Error())
+	}
+
+	collectionErrors := prometheus.NewGauge("aura_exporter_collection_errors", "Number of requests that failed in the latest collection")
+	collectionErrors.Add(float64(len(result.errors)), nil)
+	lastCollection := prometheus.NewGauge("aura_exporter_last_collection_timestamp", "Unix time of the latest collection")
+	lastCollection.Add(float64(start.Unix()), nil)
+
+	var b bytes.Buffer
+	if err := prometheus.Write(&b, append(result.gauges, collectionErrors, lastCollection)); err != nil {
+		panic(err)
+	}
+	logger.Info("metrics collected", "errors", len(result.errors), "duration", time.Since(start).String())
+	return b.Bytes(), len(result.errors)
+}
+
+// Serves the latest metrics on /metrics and collects them again every interval, until the context is cancelled
+func serve(ctx context.Context, c *collector, logger *slog.Logger, listener net.Listener, interval time.Duration) error {
+	var (
+		mu      sync.RWMutex
+		metrics []byte
+	)
+	metrics, _ = collectMetrics(c, logger)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /metrics", func(w http.ResponseWriter, r *http.Request) {
+		mu.RLock()
+		defer mu.RUnlock()
+		w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+		w.Write(metrics)
+	})
+	server := &http.Server{Handler: mux, ReadHeaderTimeout: 10 * time.Second}
+	serveErr := make(chan error, 1)
+	go func() {
+		serveErr <- server.Serve(listener)
+	}()
+	logger.Info("exporter started", "address", listener.Addr().String(), "interval", interval.String())
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case err := <-serveErr:
+			return clierr.NewUpstreamError("cannot serve the metrics: %w", err)
+		case <-ctx.Done():
+			shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+			if err := server.Shutdown(shutdownCtx); err != nil && !errors.Is(err, http.ErrServerClosed) {
+				return err
+			}
+			logger.Info("exporter stopped")
+			return nil
+		case <-ticker.C:
+			collected, _ := collectMetrics(c, logger)
+			mu.Lock()
+			metrics = collected
+			mu.Unlock()
+		}
+	}
+}
@@ -0,0 +1,137 @@
+// Copyright (c) "Neo4j"
+// Neo4j Sweden AB [http://neo4j.com]
+
+package exporter_test
+
+import (
+	"net/http"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/test/testutils"
+	"github.com/stretchr/testify/assert"
+)
+
+// Removes the time of the collection, which differs between runs
+func withoutCollectionTime(metrics string) string {
+	return regexp.MustCompile(`(?m)^aura_exporter_last_collection_timestamp \S+$`).ReplaceAllString(metrics, "aura_exporter_last_collection_timestamp <time>")
+}
+
+func TestExporterOnce(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.NewRequestHandlerMock("GET /v1/instances", http.StatusOK, `{"data": [
+		{"id": "2f49c2b3", "name": "orders", "tenant_id": "YOUR_TENANT_ID"},
+		{"id": "d00dfeed", "name": "archive", "tenant_id": "YOUR_TENANT_ID"}
+	]}`)
+	helper.NewRequestHandlerMock("GET /v1/instances/2f49c2b3", http.StatusOK, `{"data": {"id": "2f49c2b3", "name": "orders", "tenant_id": "YOUR_TENANT_ID", "status": "running", "memory": "8GB"}}`)
+	helper.NewRequestHandlerMock("GET /v1/instances/d00dfeed", http.StatusOK, `{"data": {"id": "d00dfeed", "name": "archive", "tenant_id": "YOUR_TENANT_ID", "status": "suspended", "memory": "2GB"}}`)
+	ordersSnapshotsMock := helper.NewRequestHandlerMock("GET /v1/instances/2f49c2b3/snapshots", http.StatusOK, `{"data": [
+		{"instance_id": "2f49c2b3", "snapshot_id": "a", "profile": "Scheduled", "status": "Completed", "timestamp": "2026-10-19T06:00:00Z"},
+		{"instance_id": "2f49c2b3", "snapshot_id": "b", "profile": "Scheduled", "status": "Completed", "timestamp": "2026-10-19T12:00:00Z"},
+		{"instance_id": "2f49c2b3", "snapshot_id": "c", "profile": "Scheduled", "status": "Failed", "timestamp": "2026-10-19T18:00:00Z"}
+	]}`)
+	// No snapshot of the current day completed, so the previous day is looked at
+	archiveSnapshotsMock := helper.NewRequestHandlerMock("GET /v1/instances/d00dfeed/snapshots", http.StatusOK, `{"data": []}`).
+		AddResponse(http.StatusOK, `{"data": [{"instance_id": "d00dfeed", "snapshot_id": "d", "profile": "Scheduled", "status": "Completed", "timestamp": "2026-10-18T00:00:00Z"}]}`)
+	helper.NewRequestHandlerMock("GET /v1/graph-analytics/sessions", http.StatusOK, `{"data": [
+		{"id": "s-04de43fe-67ab-4", "name": "people-and-fruits", "instance_id": null, "status": "Ready", "expiry_date": "2026-10-20T09:32:35Z", "tenant_id": "YOUR_TENANT_ID"},
+		{"id": "2f49c2b3-15de43fg", "name": "orders \"analysis\"", "instance_id": "2f49c2b3", "status": "Expired", "expiry_date": null, "tenant_id": "YOUR_TENANT_ID"}
+	]}`)
+
+	helper.ExecuteCommand("exporter --once")
+
+	ordersSnapshotsMock.AssertCalledTimes(1)
+	ordersSnapshotsMock.AssertCalledWithQueryParam("date", time.Now().UTC().Format(time.DateOnly))
+	archiveSnapshotsMock.AssertCalledTimes(2)
+	assert.Contains(t, helper.PrintErr(), `level=INFO msg="metrics collected" errors=0`)
+	assert.Equal(t, `# HELP aura_instance_status Status of the instance, 1 for its current status
+# TYPE aura_instance_status gauge
+aura_instance_status{id="2f49c2b3",name="orders",status="running",tenant="YOUR_TENANT_ID"} 1
+aura_instance_status{id="d00dfeed",name="archive",status="suspended",tenant="YOUR_TENANT_ID"} 1
+# HELP aura_instance_memory_bytes Memory of the instance in bytes
+# TYPE aura_instance_memory_bytes gauge
+aura_instance_memory_bytes{id="2f49c2b3",name="orders",tenant="YOUR_TENANT_ID"} 8589934592
+aura_instance_memory_bytes{id="d00dfeed",name="archive",tenant="YOUR_TENANT_ID"} 2147483648
+# HELP aura_snapshot_last_completed_timestamp Unix time of the latest completed snapshot of the instance of the current or the previous day (UTC)
+# TYPE aura_snapshot_last_completed_timestamp gauge
+aura_snapshot_last_completed_timestamp{id="2f49c2b3",name="orders",tenant="YOUR_TENANT_ID"} 1792411200
+aura_snapshot_last_completed_timestamp{id="d00dfeed",name="archive",tenant="YOUR_TENANT_ID"} 1792281600
+# HELP aura_graph_analytics_session_status Status of the Graph Analytics session, 1 for its current status
+# TYPE aura_graph_analytics_session_status gauge
+aura_graph_analytics_session_status{id="s-04de43fe-67ab-4",instance_id="",name="people-and-fruits",status="Ready",tenant="YOUR_TENANT_ID"} 1
+aura_graph_analytics_session_status{id="2f49c2b3-15de43fg",instance_id="2f49c2b3",name="orders \"analysis\"",status="Expired",tenant="YOUR_TENANT_ID"} 1
+# HELP aura_graph_analytics_session_expiry_timestamp Unix time at which the Graph Analytics session expires
+# TYPE aura_graph_analytics_session_expiry_timestamp gauge
+aura_graph_analytics_session_expiry_timestamp{id="s-04de43fe-67ab-4",instance_id="",name="people-and-fruits",tenant="YOUR_TENANT_ID"} 1792488755
+# HELP aura_exporter_collection_errors Number of requests that failed in the latest collection
+# TYPE aura_exporter_collection_errors gauge
+aura_exporter_collection_errors 0
+# HELP aura_exporter_last_collection_timestamp Unix time of the latest collection
+# TYPE aura_exporter_last_collection_timestamp gauge
+aura_exporter_last_collection_timestamp <time>
+`, withoutCollectionTime(helper.PrintOut()))
+}
+
+func TestExporterOnceWithFleetServers(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	organizationId := "81e4ae5c-171b-4700-b243-8d1dd34f7321"
+	projectId := "ef7faf53-fb7e-4994-8d0f-64ae56e91c42"
+	helper.SetConfigValue("aura.beta-enabled", true)
+	helper.SetDefaultProjectInConfig(organizationId, projectId)
+	helper.NewRequestHandlerMock("GET /v1beta5/instances", http.StatusOK, `{"data": []}`)
+	helper.NewRequestHandlerMock("GET /v1beta5/graph-analytics/sessions", http.StatusOK, `{"data": []}`)
+	helper.NewRequestHandlerMock("GET /v2beta1/organizations/"+organizationId+"/projects/"+projectId+"/fleet-manager/deployments", http.StatusOK, `{"data": [
+		{"id": "9a1e6181-7d0b-48a2-bc2b-4250c36b5cc2", "name": "on-prem", "status": "active"}
+	]}`)
+	lastPing := time.Now().Add(-90 * time.Second).UTC().Format(time.RFC3339)
+	helper.NewRequestHandlerMock("GET /v2beta1/organizations/"+organizationId+"/projects/"+projectId+"/fleet-manager/deployments/9a1e6181-7d0b-48a2-bc2b-4250c36b5cc2/servers", http.StatusOK, `{"data": [
+		{"id": "66c6ee3b-de03-4e8a-ba57-066b34730092", "name": "db-1", "status": "online", "last_ping": "`+lastPing+`"},
+		{"id": "77d7ff4c-ef14-5f9b-cb68-177c45841103", "name": "db-2", "status": "offline", "last_ping": null}
+	]}`)
+
+	helper.ExecuteCommand("exporter --once")
+
+	out := helper.PrintOut()
+	assert.Contains(t, out, `
+aura_fleet_server_status{deployment_id="9a1e6181-7d0b-48a2-bc2b-4250c36b5cc2",deployment_name="on-prem",id="66c6ee3b-de03-4e8a-ba57-066b34730092",name="db-1",status="online"} 1
+aura_fleet_server_status{deployment_id="9a1e6181-7d0b-48a2-bc2b-4250c36b5cc2",deployment_name="on-prem",id="77d7ff4c-ef14-5f9b-cb68-177c45841103",name="db-2",status="offline"} 1
+`)
+	ping := regexp.MustCompile(`(?m)^aura_fleet_server_last_ping_seconds\{deployment_id="9a1e6181-7d0b-48a2-bc2b-4250c36b5cc2",deployment_name="on-prem",id="66c6ee3b-de03-4e8a-ba57-066b34730092",name="db-1"\} (9\d(\.\d+)?)$`)
+	assert.Regexp(t, ping, out)
+	assert.Equal(t, 1, strings.Count(out, "aura_fleet_server_last_ping_seconds{"))
+}
+
+func TestExporterOnceWithFailedRequests(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.NewRequestHandlerMock("GET /v1/instances", http.StatusOK, `{"data": [{"id": "2f49c2b3", "name": "orders", "tenant_id": "YOUR_TENANT_ID"}]}`)
+	helper.NewRequestHandlerMock("GET /v1/instances/2f49c2b3", http.StatusOK, `{"data": {"id": "2f49c2b3", "name": "orders", "tenant_id": "YOUR_TENANT_ID", "status": "running", "memory": "8GB"}}`)
+	helper.NewRequestHandlerMock("GET /v1/instances/2f49c2b3/snapshots", http.StatusInternalServerError, `{"errors": [{"message": "Internal server error", "reason": "internal-server-error"}]}`)
+	helper.NewRequestHandlerMock("GET /v1/graph-analytics/sessions", http.StatusOK, `{"data": []}`)
+
+	helper.ExecuteCommand("exporter --once")
+
+	out := helper.PrintOut()
+	assert.Contains(t, out, `aura_instance_status{id="2f49c2b3",name="orders",status="running",tenant="YOUR_TENANT_ID"} 1`)
+	assert.NotContains(t, out, `aura_snapshot_last_completed_timestamp{`)
+	assert.Contains(t, out, "aura_exporter_collection_errors 1\n")
+	err := helper.PrintErr()
+	assert.Contains(t, err, `level=WARN msg="request failed"`)
+	assert.True(t, strings.HasSuffix(err, "Error: the collection is incomplete, 1 requests failed\n"))
+}
+
+func TestExporterWithInvalidInterval(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.ExecuteCommand("exporter --interval 0s")
+
+	assert.Contains(t, helper.PrintErr(), `Error: invalid argument "0s" for "--interval" flag: must be greater than 0`)
+}
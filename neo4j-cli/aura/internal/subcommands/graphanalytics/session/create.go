@@ -9,6 +9,7 @@ import (
 	"github.com/neo4j/cli/common/clicfg"
 	"github.com/neo4j/cli/neo4j-cli/aura/internal/api"
 	"github.com/neo4j/cli/neo4j-cli/aura/internal/output"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/subcommands/utils"
 	"github.com/spf13/cobra"
 )
 
@@ -65,8 +66,13 @@ func NewCreateCmd(cfg *clicfg.Config) *cobra.Command {
 				body["ttl"] = ttl
 			}
 
+			cmd.SilenceUsage = true
 			if instance_id != "" {
-				body["instance_id"] = instance_id
+				resolvedInstanceId, err := utils.ResolveInstanceId(cfg, instance_id)
+				if err != nil {
+					return err
+				}
+				body["instance_id"] = resolvedInstanceId
 			}
 
 			if cloudProvider != "" {
@@ -78,12 +84,16 @@ func NewCreateCmd(cfg *clicfg.Config) *cobra.Command {
 			}
 
 			if tenant_id == "" && instance_id == "" {
-				body["tenant_id"] = cfg.Aura.DefaultTenant()
-			} else if tenant_id != "" {
-				body["tenant_id"] = tenant_id
+				tenant_id = cfg.Aura.DefaultTenant()
+			}
+			if tenant_id != "" {
+				resolvedTenantId, err := utils.ResolveTenantId(cfg, tenant_id)
+				if err != nil {
+					return err
+				}
+				body["tenant_id"] = resolvedTenantId
 			}
 
-			cmd.SilenceUsage = true
 			resBody, statusCode, err := api.MakeRequest(cfg, "/graph-analytics/sessions", &api.RequestConfig{
 				PostBody: body,
 				Method:   http.MethodPost,
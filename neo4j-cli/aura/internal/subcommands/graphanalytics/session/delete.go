@@ -10,19 +10,36 @@ import (
 	"github.com/neo4j/cli/common/clicfg"
 	"github.com/neo4j/cli/neo4j-cli/aura/internal/api"
 	"github.com/neo4j/cli/neo4j-cli/aura/internal/output"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/subcommands/utils"
 	"github.com/spf13/cobra"
 )
 
 func NewDeleteCmd(cfg *clicfg.Config) *cobra.Command {
+	var confirmation utils.ConfirmationFlags
+
 	cmd := &cobra.Command{
 		Use:   "delete <id>",
 		Args:  cobra.ExactArgs(1),
 		Short: "Delete a Graph Analytics Serverless session",
-		Long:  `This subcommand deletes a Graph Analytics Serverless session by id.`,
+		Long:  `This subcommand deletes a Graph Analytics Serverless session by id.` + utils.ConfirmationHelp,
 		RunE: func(cmd *cobra.Command, args []string) error {
-			path := fmt.Sprintf("/graph-analytics/sessions/%s", args[0])
-
 			cmd.SilenceUsage = true
+			sessionId, err := utils.ResolveSessionId(cfg, args[0])
+			if err != nil {
+				return err
+			}
+
+			path := fmt.Sprintf("/graph-analytics/sessions/%s", sessionId)
+			if err := confirmation.Confirm(cmd, cfg, utils.DestructiveOperation{
+				Kind:      "session",
+				Id:        sessionId,
+				Operation: "delete",
+				Question:  fmt.Sprintf("Delete session %s?", sessionId),
+				Name:      utils.ResourceName(cfg, path, api.AuraApiVersion1),
+			}); err != nil {
+				return err
+			}
+
 			resBody, statusCode, err := api.MakeRequest(cfg, path, &api.RequestConfig{
 				Method: http.MethodDelete,
 			})
@@ -36,5 +53,8 @@ func NewDeleteCmd(cfg *clicfg.Config) *cobra.Command {
 			return nil
 		},
 	}
+
+	utils.AddConfirmationFlags(cmd, &confirmation)
+
 	return cmd
 }
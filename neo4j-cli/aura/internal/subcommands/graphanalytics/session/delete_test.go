@@ -64,3 +64,39 @@ func TestDeleteSessionError(t *testing.T) {
 	helper.AssertErr("Error: [session with id s-f5138f3b-7956 not found]")
 
 }
+
+func TestDeleteSessionByName(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.NewRequestHandlerMock("GET /v1/graph-analytics/sessions", http.StatusOK, `{
+		"data": [
+			{"id": "559c94c7-15de43f0", "name": "people-and-fruits"},
+			{"id": "8e1a3d2b-55aa44bb", "name": "other"}
+		]
+	}`)
+	mockHandler := helper.NewRequestHandlerMock("DELETE /v1/graph-analytics/sessions/559c94c7-15de43f0", http.StatusAccepted, `{"data": {"id": "559c94c7-15de43f0"}}`)
+
+	helper.ExecuteCommand("graph-analytics session delete people-and-fruits")
+
+	mockHandler.AssertCalledTimes(1)
+	helper.AssertErr("")
+}
+
+func TestDeleteSessionAsksForConfirmation(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	mockHandler := helper.NewRequestHandlerMock("DELETE /v1/graph-analytics/sessions/42-24", http.StatusAccepted, `{"data": {"id": "42-24"}}`)
+
+	helper.SetTerminalInput("yes\n")
+	helper.ExecuteCommand("graph-analytics session delete 42-24")
+
+	mockHandler.AssertCalledTimes(1)
+	helper.AssertErr("")
+	helper.AssertOut(`Delete session 42-24? [y/N]: {
+	"data": {
+		"id": "42-24"
+	}
+}`)
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/neo4j/cli/common/clicfg"
 	"github.com/neo4j/cli/neo4j-cli/aura/internal/api"
 	"github.com/neo4j/cli/neo4j-cli/aura/internal/output"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/subcommands/utils"
 	"github.com/spf13/cobra"
 )
 
@@ -20,9 +21,14 @@ func NewGetCmd(cfg *clicfg.Config) *cobra.Command {
 		Short: "Get a Graph Analytics Serverless session",
 		Long:  `This subcommand returns the details of a Graph Analytics Serverless session.`,
 		RunE: func(cmd *cobra.Command, args []string) error {
-			path := fmt.Sprintf("/graph-analytics/sessions/%s", args[0])
-
 			cmd.SilenceUsage = true
+			sessionId, err := utils.ResolveSessionId(cfg, args[0])
+			if err != nil {
+				return err
+			}
+
+			path := fmt.Sprintf("/graph-analytics/sessions/%s", sessionId)
+
 			resBody, statusCode, err := api.MakeRequest(cfg, path, &api.RequestConfig{
 				Method: http.MethodGet,
 			})
@@ -9,6 +9,7 @@ import (
 	"github.com/neo4j/cli/common/clicfg"
 	"github.com/neo4j/cli/neo4j-cli/aura/internal/api"
 	"github.com/neo4j/cli/neo4j-cli/aura/internal/output"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/subcommands/utils"
 	"github.com/spf13/cobra"
 )
 
@@ -30,18 +31,26 @@ func NewListCmd(cfg *clicfg.Config) *cobra.Command {
 		RunE: func(cmd *cobra.Command, args []string) error {
 			path := "/graph-analytics/sessions"
 
+			cmd.SilenceUsage = true
 			queryParams := make(map[string]string)
 			if organizationId != "" {
 				queryParams["organizationId"] = organizationId
 			}
 			if tenantId != "" {
-				queryParams["tenantId"] = tenantId
+				resolvedTenantId, err := utils.ResolveTenantId(cfg, tenantId)
+				if err != nil {
+					return err
+				}
+				queryParams["tenantId"] = resolvedTenantId
 			}
 			if instanceId != "" {
-				queryParams["instanceId"] = instanceId
+				resolvedInstanceId, err := utils.ResolveInstanceId(cfg, instanceId)
+				if err != nil {
+					return err
+				}
+				queryParams["instanceId"] = resolvedInstanceId
 			}
 
-			cmd.SilenceUsage = true
 			resBody, statusCode, err := api.MakeRequest(cfg, path, &api.RequestConfig{
 				Method:      http.MethodGet,
 				QueryParams: queryParams,
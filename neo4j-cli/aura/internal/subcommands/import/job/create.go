@@ -25,6 +25,7 @@ func NewCreateCmd(cfg *clicfg.Config) *cobra.Command {
 		user           string
 		password       string
 		importType     flags.ImportType = "online"
+		confirmation   utils.ConfirmationFlags
 	)
 
 	const (
@@ -39,6 +40,9 @@ func NewCreateCmd(cfg *clicfg.Config) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "create",
 		Short: "Allows you to create a new import job",
+		Long: `Creates an import job importing data into an Aura instance with an import model.
+
+Bulk imports, created with --import-type bulk, overwrite all existing data of the instance.` + utils.ConfirmationHelp + ` These only apply to bulk imports, and the protected resources are matched against the instance.`,
 		PreRunE: func(cmd *cobra.Command, args []string) error {
 			return utils.SetProjectFlagsAsRequired(cfg, cmd)
 		},
@@ -48,6 +52,24 @@ func NewCreateCmd(cfg *clicfg.Config) *cobra.Command {
 				return err
 			}
 
+			cmd.SilenceUsage = true
+			auraDbId, err := utils.ResolveInstanceId(cfg, auraDbId)
+			if err != nil {
+				return err
+			}
+
+			if importType == "bulk" {
+				if err := confirmation.Confirm(cmd, cfg, utils.DestructiveOperation{
+					Kind:      "instance",
+					Id:        auraDbId,
+					Operation: "overwrite",
+					Question:  fmt.Sprintf("Overwrite all data of instance %s with a bulk import?", auraDbId),
+					Name:      utils.ResourceName(cfg, fmt.Sprintf("/instances/%s", auraDbId), api.AuraApiVersion1),
+				}); err != nil {
+					return err
+				}
+			}
+
 			path := fmt.Sprintf("/organizations/%s/projects/%s/import/jobs", organizationId, projectId)
 
 			responseBody, statusCode, err := api.MakeRequest(cfg, path, &api.RequestConfig{
@@ -81,6 +103,8 @@ func NewCreateCmd(cfg *clicfg.Config) *cobra.Command {
 	cmd.Flags().StringVar(&password, passwordFlag, "", "Password to use for authentication")
 	cmd.Flags().Var(&importType, importTypeFlag, "Type of import to perform. Warning: Bulk imports overwrite all existing data in the database.")
 
+	utils.AddConfirmationFlags(cmd, &confirmation)
+
 	err := cmd.MarkFlagRequired(importModelIdFlag)
 	if err != nil {
 		log.Fatal(err)
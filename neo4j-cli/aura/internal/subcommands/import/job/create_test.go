@@ -182,3 +182,39 @@ func TestCreateImportJobError(t *testing.T) {
 		})
 	}
 }
+
+func TestCreateBulkImportJobAsksForConfirmation(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	mockHandler := helper.NewRequestHandlerMock("/v2beta1/organizations/f607bebe-0cc0-4166-b60c-b4eed69ee7ee/projects/f607bebe-0cc0-4166-b60c-b4eed69ee7ee/import/jobs", http.StatusCreated, `{"data": {"id": "87d485b4-73fc-4a7f-bb03-720f4672947e"}}`)
+
+	helper.SetConfigValue("aura.beta-enabled", true)
+
+	helper.SetTerminalInput("n\n")
+	helper.ExecuteCommand("import job create --organization-id=f607bebe-0cc0-4166-b60c-b4eed69ee7ee --project-id=f607bebe-0cc0-4166-b60c-b4eed69ee7ee --import-model-id=e01cdc6d-2f50-4f46-b04b-8ec8fc8de839 --db-id=07e49cf5 --import-type=bulk")
+
+	mockHandler.AssertCalledTimes(0)
+	helper.AssertOut("Overwrite all data of instance 07e49cf5 with a bulk import? [y/N]:")
+	helper.AssertErr("Error: the operation was not confirmed")
+}
+
+func TestCreateImportJobIntoProtectedInstance(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	mockHandler := helper.NewRequestHandlerMock("/v2beta1/organizations/f607bebe-0cc0-4166-b60c-b4eed69ee7ee/projects/f607bebe-0cc0-4166-b60c-b4eed69ee7ee/import/jobs", http.StatusCreated, `{"data": {"id": "87d485b4-73fc-4a7f-bb03-720f4672947e"}}`)
+
+	helper.SetConfigValue("aura.beta-enabled", true)
+	helper.SetConfigValue("aura.protected-resources", []string{"07e49cf5"})
+
+	helper.ExecuteCommand("import job create --organization-id=f607bebe-0cc0-4166-b60c-b4eed69ee7ee --project-id=f607bebe-0cc0-4166-b60c-b4eed69ee7ee --import-model-id=e01cdc6d-2f50-4f46-b04b-8ec8fc8de839 --db-id=07e49cf5 --import-type=bulk")
+
+	mockHandler.AssertCalledTimes(0)
+	helper.AssertErr("Error: instance 07e49cf5 is protected by the protected-resources config value, use --force to overwrite it")
+
+	// Online imports do not overwrite data
+	helper.ExecuteCommand("import job create --organization-id=f607bebe-0cc0-4166-b60c-b4eed69ee7ee --project-id=f607bebe-0cc0-4166-b60c-b4eed69ee7ee --import-model-id=e01cdc6d-2f50-4f46-b04b-8ec8fc8de839 --db-id=07e49cf5")
+
+	mockHandler.AssertCalledTimes(1)
+}
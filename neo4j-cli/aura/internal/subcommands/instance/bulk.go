@@ -0,0 +1,363 @@
+// Copyright (c) "Neo4j"
+// Neo4j Sweden AB [http://neo4j.com]
+
+package instance
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"github.com/neo4j/cli/common/clicfg"
+	"github.com/neo4j/cli/common/clierr"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/api"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/output"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/subcommands/utils"
+	"github.com/spf13/cobra"
+)
+
+const (
+	allFlag         = "all"
+	selectorFlag    = "selector"
+	concurrencyFlag = "concurrency"
+)
+
+const bulkHelp = `
+
+Instead of a single instance ID, --all applies the operation to every instance the current credential has access to, and --selector to the instances matching all of the given key=value terms. The keys are:
+  name            a regular expression the instance name has to match, e.g. name=^dev-
+  tenant          the tenant ID or name, at most one tenant can be selected
+  type            the instance type, e.g. type=professional-db
+  cloud-provider  the cloud provider, e.g. cloud-provider=gcp
+  region          the region, e.g. region=europe-west1
+
+The instances are processed concurrently, at most --concurrency at a time, and a result is printed for each of them. The command exits with a non-zero status when the operation failed for any instance.`
+
+type bulkFlags struct {
+	all         bool
+	selector    []string
+	concurrency int
+}
+
+func addBulkFlags(cmd *cobra.Command, flags *bulkFlags) {
+	cmd.Flags().BoolVar(&flags.all, allFlag, false, "Applies the operation to all instances")
+
+	cmd.Flags().StringArrayVar(&flags.selector, selectorFlag, []string{}, "Applies the operation to the instances matching a key=value term, can be repeated")
+
+	cmd.Flags().IntVar(&flags.concurrency, concurrencyFlag, 4, "The number of instances processed at the same time with --all or --selector")
+
+	cmd.MarkFlagsMutuallyExclusive(allFlag, selectorFlag)
+}
+
+func (flags *bulkFlags) enabled() bool {
+	return flags.all || len(flags.selector) > 0
+}
+
+// Checks the arguments of a command accepting either an instance ID or the bulk flags
+func (flags *bulkFlags) validateArgs(args []string) error {
+	if flags.enabled() {
+		if len(args) > 0 {
+			return clierr.NewUsageError("an instance ID cannot be given together with --all or --selector")
+		}
+		if flags.concurrency < 1 {
+			return clierr.NewUsageError(`invalid argument "%d" for "--concurrency" flag: must be at least 1`, flags.concurrency)
+		}
+		return nil
+	}
+	if len(args) != 1 {
+		return clierr.NewUsageError("requires an instance ID, --all or --selector")
+	}
+	return nil
+}
+
+// Selects instances by name, tenant, type, cloud provider and region, all of its terms have to match
+type InstanceSelector struct {
+	names          []*regexp.Regexp
+	tenants        []string
+	types          []string
+	cloudProviders []string
+	regions        []string
+}
+
+// Parses the key=value terms of --selector, see bulkHelp for the keys
+func ParseSelector(terms []string) (*InstanceSelector, error) {
+	selector := &InstanceSelector{}
+	for _, term := range terms {
+		key, value, ok := strings.Cut(term, "=")
+		if !ok || value == "" {
+			return nil, clierr.NewUsageError(`invalid argument "%s" for "--selector" flag: must be of the form key=value`, term)
+		}
+
+		switch key {
+		case "name":
+			pattern, err := regexp.Compile(value)
+			if err != nil {
+				return nil, clierr.NewUsageError(`invalid argument "%s" for "--selector" flag: %s`, term, err)
+			}
+			selector.names = append(selector.names, pattern)
+		case "tenant":
+			// An instance is in a single tenant, so two tenant terms can never both match
+			if len(selector.tenants) > 0 {
+				return nil, clierr.NewUsageError(`invalid argument "%s" for "--selector" flag: only one tenant can be selected`, term)
+			}
+			selector.tenants = append(selector.tenants, value)
+		case "type":
+			selector.types = append(selector.types, value)
+		case "cloud-provider":
+			selector.cloudProviders = append(selector.cloudProviders, value)
+		case "region":
+			selector.regions = append(selector.regions, value)
+		default:
+			return nil, clierr.NewUsageError(`invalid argument "%s" for "--selector" flag: key must be one of "name", "tenant", "type", "cloud-provider", or "region"`, term)
+		}
+	}
+	return selector, nil
+}
+
+// Returns whether the instance matches every term of the selector
+func (selector *InstanceSelector) matches(instance map[string]any) bool {
+	for _, pattern := range selector.names {
+		if !pattern.MatchString(fmt.Sprint(instance["name"])) {
+			return false
+		}
+	}
+	for field, values := range map[string][]string{
+		"tenant_id":      selector.tenants,
+		"type":           selector.types,
+		"cloud_provider": selector.cloudProviders,
+		"region":         selector.regions,
+	} {
+		for _, value := range values {
+			if fmt.Sprint(instance[field]) != value {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// An operation applied to many instances at once
+type BulkAction struct {
+	// Past participle of the operation used in messages, e.g. paused
+	done string
+	// Status an instance needs to have for the operation to apply, instances with another status are skipped. Empty when the operation applies to any instance
+	requiredStatus string
+	// Asks for confirmation of the operation on the selected instances, which is not applied to any of them when it fails. Optional
+	confirm func(rows []map[string]any) error
+	// Runs before the operation on each instance it applies to, returning a message to show on success. The operation is not started when it fails. Optional
+	before func(cfg *clicfg.Config, instance map[string]any) (string, error)
+	// Sends the request starting the operation and returns the status of the instance
+	run func(cfg *clicfg.Config, instanceId string) (string, error)
+	// Waits for the operation to complete and returns the final status of the instance
+	await func(cfg *clicfg.Config, instanceId string) (string, error)
+}
+
+func runBulkAction(cmd *cobra.Command, cfg *clicfg.Config, flags *bulkFlags, action BulkAction, await bool) error {
+	selector, err := ParseSelector(flags.selector)
+	if err != nil {
+		return err
+	}
+
+	cmd.SilenceUsage = true
+	rows, err := ApplyBulkAction(cfg, selector, flags.concurrency, action, await)
+	if err != nil {
+		return err
+	}
+
+	output.PrintBodyMap(cmd, cfg, api.NewListResponseData(rows), []string{"id", "name", "tenant_id", "status", "result", "message"})
+
+	failed := 0
+	for _, row := range rows {
+		if row["result"] == BulkResultFailed {
+			failed++
+		}
+	}
+	if failed > 0 {
+		return clierr.NewUpstreamError("%d of %d instances could not be %s", failed, len(rows), action.done)
+	}
+	return nil
+}
+
+const (
+	BulkResultSucceeded = "succeeded"
+	BulkResultSkipped   = "skipped"
+	BulkResultFailed    = "failed"
+)
+
+// Applies the action to the instances matching the selector, running at most concurrency operations at the same time. Returns a result for each instance,
+// with its id, name, tenant_id, status, the result, one of succeeded, skipped or failed, and a message explaining why the instance was skipped or failed.
+func ApplyBulkAction(cfg *clicfg.Config, selector *InstanceSelector, concurrency int, action BulkAction, await bool) ([]map[string]any, error) {
+	queryParams := map[string]string{}
+	for i, tenant := range selector.tenants {
+		resolvedTenantId, err := utils.ResolveTenantId(cfg, tenant)
+		if err != nil {
+			return nil, err
+		}
+		selector.tenants[i] = resolvedTenantId
+		queryParams["tenantId"] = resolvedTenantId
+	}
+
+	resBody, _, err := api.MakeRequest(cfg, "/instances", &api.RequestConfig{
+		Method:      http.MethodGet,
+		QueryParams: queryParams,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// The list only has a summary of each instance, the details are needed for the status, type and region
+	listed := api.ParseBody(resBody).AsArray()
+	instances := make([]map[string]any, len(listed))
+	detailErrors := make([]error, len(listed))
+	utils.RunConcurrently(concurrency, len(listed), func(i int) {
+		resBody, _, err := api.MakeRequest(cfg, fmt.Sprintf("/instances/%s", listed[i]["id"]), &api.RequestConfig{
+			Method: http.MethodGet,
+		})
+		if err != nil {
+			instances[i] = listed[i]
+			detailErrors[i] = err
+			return
+		}
+		instances[i], detailErrors[i] = api.ParseBody(resBody).GetSingleOrError()
+	})
+
+	rows := []map[string]any{}
+	for i, instance := range instances {
+		if detailErrors[i] != nil {
+			if selector.matches(listed[i]) {
+				rows = append(rows, bulkResult(listed[i], BulkResultFailed, detailErrors[i].Error()))
+			}
+			continue
+		}
+		if selector.matches(instance) {
+			rows = append(rows, bulkResult(instance, "", ""))
+		}
+	}
+
+	if action.confirm != nil && len(rows) > 0 {
+		if err := action.confirm(rows); err != nil {
+			return nil, err
+		}
+	}
+
+	utils.RunConcurrently(concurrency, len(rows), func(i int) {
+		row := rows[i]
+		if row["result"] == BulkResultFailed {
+			return
+		}
+
+		instanceId := fmt.Sprint(row["id"])
+		if action.requiredStatus != "" && row["status"] != action.requiredStatus {
+			row["result"] = BulkResultSkipped
+			row["message"] = fmt.Sprintf("instance is %s", row["status"])
+			return
+		}
+
+		if action.before != nil {
+			message, err := action.before(cfg, row)
+			if err != nil {
+				row["result"] = BulkResultFailed
+				row["message"] = err.Error()
+				return
+			}
+			row["message"] = message
+		}
+
+		status, err := action.run(cfg, instanceId)
+		if err != nil {
+			row["result"] = BulkResultFailed
+			row["message"] = err.Error()
+			return
+		}
+		row["status"] = status
+
+		if await {
+			status, err := action.await(cfg, instanceId)
+			if err != nil {
+				row["result"] = BulkResultFailed
+				row["message"] = err.Error()
+				return
+			}
+			row["status"] = status
+		}
+		row["result"] = BulkResultSucceeded
+	})
+
+	return rows, nil
+}
+
+func bulkResult(instance map[string]any, result string, message string) map[string]any {
+	return map[string]any{
+		"id":        instance["id"],
+		"name":      instance["name"],
+		"tenant_id": instance["tenant_id"],
+		"status":    instance["status"],
+		"result":    result,
+		"message":   message,
+	}
+}
+
+// Sends a POST request for an operation on an instance, e.g. /pause, and returns the status of the instance
+func postInstanceAction(cfg *clicfg.Config, instanceId string, operation string) (string, error) {
+	resBody, _, err := api.MakeRequest(cfg, fmt.Sprintf("/instances/%s/%s", instanceId, operation), &api.RequestConfig{
+		Method: http.MethodPost,
+	})
+	if err != nil {
+		return "", err
+	}
+	instance, err := api.ParseBody(resBody).GetSingleOrError()
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprint(instance["status"]), nil
+}
+
+// Pauses running instances, other instances are skipped
+func PauseAction() BulkAction {
+	return BulkAction{
+		done:           "paused",
+		requiredStatus: api.InstanceStatusRunning,
+		run: func(cfg *clicfg.Config, instanceId string) (string, error) {
+			return postInstanceAction(cfg, instanceId, "pause")
+		},
+		await: func(cfg *clicfg.Config, instanceId string) (string, error) {
+			pollResponse, err := api.PollInstance(cfg, instanceId, api.InstanceStatusPausing)
+			if err != nil {
+				return "", err
+			}
+			return pollResponse.Data.Status, nil
+		},
+	}
+}
+
+// Resumes paused instances, other instances are skipped
+func ResumeAction() BulkAction {
+	return BulkAction{
+		done:           "resumed",
+		requiredStatus: api.InstanceStatusPaused,
+		run: func(cfg *clicfg.Config, instanceId string) (string, error) {
+			return postInstanceAction(cfg, instanceId, "resume")
+		},
+		await: func(cfg *clicfg.Config, instanceId string) (string, error) {
+			pollResponse, err := api.PollInstance(cfg, instanceId, api.InstanceStatusResuming)
+			if err != nil {
+				return "", err
+			}
+			return pollResponse.Data.Status, nil
+		},
+	}
+}
+
+func DeleteAction() BulkAction {
+	return BulkAction{
+		done: "deleted",
+		run:  deleteInstance,
+		await: func(cfg *clicfg.Config, instanceId string) (string, error) {
+			if err := api.PollInstanceDeleted(cfg, instanceId); err != nil {
+				return "", err
+			}
+			return "deleted", nil
+		},
+	}
+}
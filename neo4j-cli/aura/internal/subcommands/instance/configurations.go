@@ -0,0 +1,257 @@
+// Copyright (c) "Neo4j"
+// Neo4j Sweden AB [http://neo4j.com]
+
+package instance
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/neo4j/cli/common/clicfg"
+	"github.com/neo4j/cli/common/clicfg/fileutils"
+	"github.com/neo4j/cli/common/clierr"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/api"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/flags"
+)
+
+// An instance configuration a tenant supports, as listed by GET /tenants/{id}
+type instanceConfiguration struct {
+	Type          string `json:"type"`
+	CloudProvider string `json:"cloud_provider"`
+	Region        string `json:"region"`
+	RegionName    string `json:"region_name,omitempty"`
+	Memory        string `json:"memory"`
+	Storage       string `json:"storage,omitempty"`
+	Version       string `json:"version"`
+	// Not returned for every configuration, e.g. when the tenant has custom pricing
+	Pricing *instancePricing `json:"pricing,omitempty"`
+}
+
+// Prices of an instance configuration per hour, while it is running and while it is paused
+type instancePricing struct {
+	Currency         string `json:"currency"`
+	HourlyRate       price  `json:"hourly_rate"`
+	PausedHourlyRate *price `json:"paused_hourly_rate,omitempty"`
+}
+
+// A price the Aura API returns either as a number or as a decimal string
+type price float64
+
+func (p *price) UnmarshalJSON(data []byte) error {
+	var value float64
+	if err := json.Unmarshal(bytes.Trim(data, `"`), &value); err != nil {
+		return fmt.Errorf("invalid price %s: %w", data, err)
+	}
+	*p = price(value)
+	return nil
+}
+
+type tenantCache struct {
+	Tenants map[string]*cachedTenant `json:"tenants"`
+}
+
+type cachedTenant struct {
+	FetchedAt              time.Time               `json:"fetched-at"`
+	InstanceConfigurations []instanceConfiguration `json:"instance-configurations"`
+}
+
+func tenantCachePath() string {
+	return filepath.Join(clicfg.CacheDir(), "tenants.json")
+}
+
+// Returns the instance configurations of the tenant and whether they come from the cache. The cache is used while it is younger than the cache-ttl config value, unless refresh is set.
+func getInstanceConfigurations(cfg *clicfg.Config, tenantId string, refresh bool) ([]instanceConfiguration, bool, error) {
+	fs := cfg.Aura.Fs()
+	ttl := cfg.Aura.CacheTtl()
+
+	cache := tenantCache{Tenants: map[string]*cachedTenant{}}
+	if ttl > 0 {
+		if data := fileutils.ReadFileSafe(fs, tenantCachePath()); len(data) > 0 {
+			// A cache that cannot be read is overwritten below
+			_ = json.Unmarshal(data, &cache)
+			if cache.Tenants == nil {
+				cache.Tenants = map[string]*cachedTenant{}
+			}
+		}
+		if cached, ok := cache.Tenants[tenantId]; ok && !refresh && time.Since(cached.FetchedAt) < ttl {
+			return cached.InstanceConfigurations, true, nil
+		}
+	}
+
+	resBody, _, err := api.MakeRequest(cfg, fmt.Sprintf("/tenants/%s", tenantId), &api.RequestConfig{
+		Method: http.MethodGet,
+	})
+	if err != nil {
+		return nil, false, err
+	}
+
+	var response struct {
+		Data struct {
+			InstanceConfigurations []instanceConfiguration `json:"instance_configurations"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal(resBody, &response); err != nil {
+		return nil, false, clierr.NewUpstreamError("cannot parse the configurations of tenant %s: %w", tenantId, err)
+	}
+
+	if ttl > 0 {
+		cache.Tenants[tenantId] = &cachedTenant{
+			FetchedAt:              time.Now().UTC(),
+			InstanceConfigurations: response.Data.InstanceConfigurations,
+		}
+		data, err := json.MarshalIndent(cache, "", "\t")
+		if err != nil {
+			return nil, false, err
+		}
+		if err := fs.MkdirAll(clicfg.CacheDir(), 0755); err != nil {
+			return nil, false, err
+		}
+		fileutils.WriteFile(fs, tenantCachePath(), data)
+	}
+
+	return response.Data.InstanceConfigurations, false, nil
+}
+
+// Checks that the tenant supports the wanted configuration. Cached configurations that reject it are refreshed first, as they may be outdated.
+// When the configurations cannot be retrieved, only the version and memory are checked against the ones Aura has.
+func validateInstanceConfiguration(cfg *clicfg.Config, tenantId string, wanted instanceConfiguration) error {
+	configurations, cached, err := getInstanceConfigurations(cfg, tenantId, false)
+	if err != nil || len(configurations) == 0 {
+		return validateKnownInstanceConfiguration(wanted)
+	}
+
+	err = matchInstanceConfiguration(configurations, tenantId, wanted)
+	if err != nil && cached {
+		configurations, _, refreshErr := getInstanceConfigurations(cfg, tenantId, true)
+		if refreshErr != nil || len(configurations) == 0 {
+			return validateKnownInstanceConfiguration(wanted)
+		}
+		err = matchInstanceConfiguration(configurations, tenantId, wanted)
+	}
+	return err
+}
+
+// Checks the version and memory of the wanted configuration against the ones Aura has, regardless of the tenant
+func validateKnownInstanceConfiguration(wanted instanceConfiguration) error {
+	if wanted.Version != "4" && wanted.Version != "5" {
+		return clierr.NewUsageError(`invalid argument "%s" for "--version" flag: must be one of "4" or "5"`, wanted.Version)
+	}
+	if err := flags.CheckKnownMemory(wanted.Memory); err != nil {
+		return clierr.NewUsageError(`invalid argument "%s" for "--memory" flag: %s`, wanted.Memory, err)
+	}
+	return nil
+}
+
+// Narrows the configurations down field by field, so that the error names the first flag that has no valid configuration
+func matchInstanceConfiguration(configurations []instanceConfiguration, tenantId string, wanted instanceConfiguration) error {
+	if len(configurations) == 0 {
+		return nil
+	}
+
+	steps := []struct {
+		flag    string
+		value   string
+		field   func(c instanceConfiguration) string
+		context string
+	}{
+		{"type", wanted.Type, func(c instanceConfiguration) string { return c.Type }, ""},
+		{"cloud-provider", wanted.CloudProvider, func(c instanceConfiguration) string { return c.CloudProvider }, fmt.Sprintf(" for %s instances", wanted.Type)},
+		{"region", wanted.Region, func(c instanceConfiguration) string { return c.Region }, fmt.Sprintf(" for %s instances on %s", wanted.Type, wanted.CloudProvider)},
+		{"version", wanted.Version, func(c instanceConfiguration) string { return c.Version }, fmt.Sprintf(" for %s instances in %s", wanted.Type, wanted.Region)},
+		{"memory", wanted.Memory, func(c instanceConfiguration) string { return c.Memory }, fmt.Sprintf(" for %s instances of version %s in %s", wanted.Type, wanted.Version, wanted.Region)},
+	}
+
+	candidates := configurations
+	for _, step := range steps {
+		valid := []string{}
+		matching := []instanceConfiguration{}
+		for _, c := range candidates {
+			value := step.field(c)
+			if !slices.Contains(valid, value) {
+				valid = append(valid, value)
+			}
+			if value == step.value {
+				matching = append(matching, c)
+			}
+		}
+
+		if len(matching) == 0 {
+			var closest string
+			if step.flag == "memory" {
+				slices.SortFunc(valid, func(a, b string) int { return memoryInGB(a) - memoryInGB(b) })
+				closest = closestMemory(step.value, valid)
+			} else {
+				slices.Sort(valid)
+				closest = closestString(step.value, valid)
+			}
+			return clierr.NewUsageError(`invalid argument "%s" for "--%s" flag: not available%s in tenant %s, did you mean "%s"? Valid values are "%s"`,
+				step.value, step.flag, step.context, tenantId, closest, strings.Join(valid, `", "`))
+		}
+		candidates = matching
+	}
+
+	return nil
+}
+
+func memoryInGB(memory string) int {
+	value, err := strconv.Atoi(strings.TrimSuffix(memory, "GB"))
+	if err != nil {
+		return 0
+	}
+	return value
+}
+
+func closestMemory(memory string, valid []string) string {
+	closest := valid[0]
+	for _, v := range valid {
+		if abs(memoryInGB(v)-memoryInGB(memory)) < abs(memoryInGB(closest)-memoryInGB(memory)) {
+			closest = v
+		}
+	}
+	return closest
+}
+
+func closestString(value string, valid []string) string {
+	closest := valid[0]
+	for _, v := range valid {
+		if editDistance(value, v) < editDistance(value, closest) {
+			closest = v
+		}
+	}
+	return closest
+}
+
+// Levenshtein distance between two strings
+func editDistance(a string, b string) int {
+	previous := make([]int, len(b)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		current := make([]int, len(b)+1)
+		current[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			current[j] = min(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+		previous = current
+	}
+	return previous[len(b)]
+}
+
+func abs(x int) int {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
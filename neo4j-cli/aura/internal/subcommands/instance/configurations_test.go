@@ -0,0 +1,141 @@
+// Copyright (c) "Neo4j"
+// Neo4j Sweden AB [http://neo4j.com]
+
+package instance_test
+
+import (
+	"fmt"
+	"net/http"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/neo4j/cli/common/clicfg"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/test/testutils"
+	"github.com/stretchr/testify/assert"
+)
+
+const tenantWithConfigurations = `{
+	"data": {
+		"id": "YOUR_TENANT_ID",
+		"name": "Production",
+		"instance_configurations": [
+			{"cloud_provider": "gcp", "memory": "2GB", "region": "europe-west1", "region_name": "Belgium (europe-west1)", "storage": "4GB", "type": "professional-db", "version": "5"},
+			{"cloud_provider": "gcp", "memory": "8GB", "region": "europe-west1", "region_name": "Belgium (europe-west1)", "storage": "16GB", "type": "professional-db", "version": "5"},
+			{"cloud_provider": "gcp", "memory": "8GB", "region": "europe-west2", "region_name": "London (europe-west2)", "storage": "16GB", "type": "professional-db", "version": "5"},
+			{"cloud_provider": "aws", "memory": "8GB", "region": "us-east-1", "region_name": "N. Virginia (us-east-1)", "storage": "16GB", "type": "professional-db", "version": "5"}
+		]
+	}
+}`
+
+const createProfessionalInstance = "instance create --name Instance01 --type professional-db --tenant-id YOUR_TENANT_ID --cloud-provider gcp"
+
+func TestCreateInstanceWithUnavailableRegion(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.NewRequestHandlerMock("GET /v1/tenants/YOUR_TENANT_ID", http.StatusOK, tenantWithConfigurations)
+	createMock := helper.NewRequestHandlerMock("POST /v1/instances", http.StatusAccepted, `{"data": {}}`)
+
+	helper.ExecuteCommand(createProfessionalInstance + " --region europe-west3 --memory 8GB")
+
+	createMock.AssertCalledTimes(0)
+	helper.AssertErr(`Error: invalid argument "europe-west3" for "--region" flag: not available for professional-db instances on gcp in tenant YOUR_TENANT_ID, did you mean "europe-west1"? Valid values are "europe-west1", "europe-west2"`)
+}
+
+func TestCreateInstanceWithUnavailableMemory(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.NewRequestHandlerMock("GET /v1/tenants/YOUR_TENANT_ID", http.StatusOK, tenantWithConfigurations)
+
+	helper.ExecuteCommand(createProfessionalInstance + " --region europe-west1 --memory 16GB")
+
+	helper.AssertErr(`Error: invalid argument "16GB" for "--memory" flag: not available for professional-db instances of version 5 in europe-west1 in tenant YOUR_TENANT_ID, did you mean "8GB"? Valid values are "2GB", "8GB"`)
+}
+
+func TestCreateInstanceWithMemoryMissingFromFlagList(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.NewRequestHandlerMock("GET /v1/tenants/YOUR_TENANT_ID", http.StatusOK, tenantWithConfigurations)
+
+	helper.ExecuteCommand(createProfessionalInstance + " --region europe-west1 --memory 3GB")
+
+	helper.AssertErr(`Error: invalid argument "3GB" for "--memory" flag: not available for professional-db instances of version 5 in europe-west1 in tenant YOUR_TENANT_ID, did you mean "2GB"? Valid values are "2GB", "8GB"`)
+}
+
+func TestCreateInstanceWithUnavailableType(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.NewRequestHandlerMock("GET /v1/tenants/YOUR_TENANT_ID", http.StatusOK, tenantWithConfigurations)
+
+	helper.ExecuteCommand("instance create --name Instance01 --type enterprise-db --tenant-id YOUR_TENANT_ID --cloud-provider gcp --region europe-west1 --memory 8GB")
+
+	helper.AssertErr(`Error: invalid argument "enterprise-db" for "--type" flag: not available in tenant YOUR_TENANT_ID, did you mean "professional-db"? Valid values are "professional-db"`)
+}
+
+func TestCreateInstanceCachesTenantConfigurations(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	tenantMock := helper.NewRequestHandlerMock("GET /v1/tenants/YOUR_TENANT_ID", http.StatusOK, tenantWithConfigurations)
+	createMock := helper.NewRequestHandlerMock("POST /v1/instances", http.StatusAccepted, `{"data": {"id": "db1d1234"}}`)
+
+	helper.ExecuteCommand(createProfessionalInstance + " --region europe-west2 --memory 8GB")
+
+	tenantMock.AssertCalledTimes(1)
+	createMock.AssertCalledTimes(1)
+	helper.AssertErr("")
+	cache := helper.ReadFile(filepath.Join(clicfg.CacheDir(), "tenants.json"))
+	assert.Contains(t, cache, `"region": "europe-west2"`)
+}
+
+func TestCreateInstanceWithCachedTenantConfigurations(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.SetFile(filepath.Join(clicfg.CacheDir(), "tenants.json"), fmt.Sprintf(`{
+		"tenants": {
+			"YOUR_TENANT_ID": {
+				"fetched-at": "%s",
+				"instance-configurations": [
+					{"cloud_provider": "gcp", "memory": "8GB", "region": "europe-west1", "type": "professional-db", "version": "5"}
+				]
+			}
+		}
+	}`, time.Now().UTC().Format(time.RFC3339)))
+	tenantMock := helper.NewRequestHandlerMock("GET /v1/tenants/YOUR_TENANT_ID", http.StatusOK, tenantWithConfigurations)
+	createMock := helper.NewRequestHandlerMock("POST /v1/instances", http.StatusAccepted, `{"data": {"id": "db1d1234"}}`)
+
+	helper.ExecuteCommand(createProfessionalInstance + " --region europe-west1 --memory 8GB")
+
+	tenantMock.AssertCalledTimes(0)
+	createMock.AssertCalledTimes(1)
+	helper.AssertErr("")
+}
+
+func TestCreateInstanceRefreshesCachedTenantConfigurationsOnMismatch(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.SetFile(filepath.Join(clicfg.CacheDir(), "tenants.json"), fmt.Sprintf(`{
+		"tenants": {
+			"YOUR_TENANT_ID": {
+				"fetched-at": "%s",
+				"instance-configurations": [
+					{"cloud_provider": "gcp", "memory": "8GB", "region": "europe-west1", "type": "professional-db", "version": "5"}
+				]
+			}
+		}
+	}`, time.Now().UTC().Format(time.RFC3339)))
+	tenantMock := helper.NewRequestHandlerMock("GET /v1/tenants/YOUR_TENANT_ID", http.StatusOK, tenantWithConfigurations)
+	createMock := helper.NewRequestHandlerMock("POST /v1/instances", http.StatusAccepted, `{"data": {"id": "db1d1234"}}`)
+
+	helper.ExecuteCommand(createProfessionalInstance + " --region europe-west2 --memory 8GB")
+
+	tenantMock.AssertCalledTimes(1)
+	createMock.AssertCalledTimes(1)
+	helper.AssertErr("")
+}
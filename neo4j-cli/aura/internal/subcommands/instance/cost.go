@@ -0,0 +1,303 @@
+// Copyright (c) "Neo4j"
+// Neo4j Sweden AB [http://neo4j.com]
+
+package instance
+
+import (
+	"cmp"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/neo4j/cli/common/clicfg"
+	"github.com/neo4j/cli/common/clierr"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/api"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/output"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/subcommands/utils"
+	"github.com/spf13/cobra"
+)
+
+const (
+	// Average number of hours in a month, which monthly estimates are based on
+	hoursPerMonth = 730
+	// The number of instances fetched at the same time
+	costConcurrency = 4
+)
+
+var costFields = []string{"tenant_id", "id", "name", "status", "type", "region", "memory", "currency", "hourly", "monthly", "note"}
+
+func NewCostCmd(cfg *clicfg.Config) *cobra.Command {
+	var tenantId string
+
+	const tenantIdFlag = "tenant-id"
+
+	cmd := &cobra.Command{
+		Use:   "cost [id...]",
+		Short: "Estimates the hourly and monthly cost of instances",
+		Long: `This subcommand estimates the cost of the given instances, or of all instances when no ID is given, optionally only those of the tenant set with --tenant-id.
+
+The estimates use the pricing of the instance configurations of the tenant that match the type, cloud provider, region and memory of each instance. Monthly estimates are based on 730 hours a month.
+
+Running instances are totalled per tenant. Paused instances are shown separately with the rate of a paused instance, when the tenant returns one, and have their own total. Instances in any other status than paused are counted as running. Instances that no priced configuration matches are listed with a note and left out of the totals.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			instanceIds := []string{}
+			for _, arg := range args {
+				instanceId, err := utils.ResolveInstanceId(cfg, arg)
+				if err != nil {
+					return err
+				}
+				instanceIds = append(instanceIds, instanceId)
+			}
+			if len(instanceIds) == 0 {
+				queryParams := map[string]string{}
+				if tenantId != "" {
+					resolvedTenantId, err := utils.ResolveTenantId(cfg, tenantId)
+					if err != nil {
+						return err
+					}
+					queryParams["tenantId"] = resolvedTenantId
+				}
+				resBody, _, err := api.MakeRequest(cfg, "/instances", &api.RequestConfig{
+					Method:      http.MethodGet,
+					QueryParams: queryParams,
+				})
+				if err != nil {
+					return err
+				}
+				for _, instance := range api.ParseBody(resBody).AsArray() {
+					instanceIds = append(instanceIds, fmt.Sprint(instance["id"]))
+				}
+			}
+
+			instances := make([]map[string]any, len(instanceIds))
+			errs := make([]error, len(instanceIds))
+			utils.RunConcurrently(costConcurrency, len(instanceIds), func(i int) {
+				resBody, _, err := api.MakeRequest(cfg, fmt.Sprintf("/instances/%s", instanceIds[i]), &api.RequestConfig{
+					Method: http.MethodGet,
+				})
+				if err != nil {
+					errs[i] = err
+					return
+				}
+				instances[i], errs[i] = api.ParseBody(resBody).GetSingleOrError()
+			})
+			for _, err := range errs {
+				if err != nil {
+					return err
+				}
+			}
+
+			rows, err := costRows(cfg, instances)
+			if err != nil {
+				return err
+			}
+			output.PrintBodyMap(cmd, cfg, api.NewListResponseData(rows), costFields)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&tenantId, tenantIdFlag, "", "Only estimates the instances of this tenant/project when no ID is given")
+
+	return cmd
+}
+
+// Returns a row per instance and the totals of each tenant, with the running instances of a tenant followed by their total and then the paused instances and their total
+func costRows(cfg *clicfg.Config, instances []map[string]any) ([]map[string]any, error) {
+	slices.SortStableFunc(instances, func(a, b map[string]any) int {
+		return cmp.Or(
+			strings.Compare(fmt.Sprint(a["tenant_id"]), fmt.Sprint(b["tenant_id"])),
+			strings.Compare(fmt.Sprint(a["name"]), fmt.Sprint(b["name"])),
+		)
+	})
+
+	lookup := newPricingLookup(cfg)
+	rows := []map[string]any{}
+	for start := 0; start < len(instances); {
+		tenantId := fmt.Sprint(instances[start]["tenant_id"])
+		end := start
+		for end < len(instances) && fmt.Sprint(instances[end]["tenant_id"]) == tenantId {
+			end++
+		}
+
+		running := costGroup{label: "running"}
+		paused := costGroup{label: "paused"}
+		for _, instance := range instances[start:end] {
+			pricing, err := lookup.find(tenantId, instanceConfiguration{
+				Type:          fmt.Sprint(instance["type"]),
+				CloudProvider: fmt.Sprint(instance["cloud_provider"]),
+				Region:        fmt.Sprint(instance["region"]),
+				Memory:        fmt.Sprint(instance["memory"]),
+			})
+			if err != nil {
+				return nil, err
+			}
+
+			row := map[string]any{}
+			for _, field := range []string{"tenant_id", "id", "name", "status", "type", "region", "memory"} {
+				row[field] = instance[field]
+			}
+			if instance["status"] == api.InstanceStatusPaused {
+				paused.add(row, pricing, true)
+			} else {
+				running.add(row, pricing, false)
+			}
+		}
+
+		rows = append(rows, running.rows(tenantId)...)
+		rows = append(rows, paused.rows(tenantId)...)
+		start = end
+	}
+	return rows, nil
+}
+
+// The instances of a tenant that are billed alike, with their total per currency
+type costGroup struct {
+	label      string
+	instances  []map[string]any
+	currencies []string
+	totals     map[string]float64
+	unpriced   int
+}
+
+func (g *costGroup) add(row map[string]any, pricing *instancePricing, paused bool) {
+	g.instances = append(g.instances, row)
+
+	var rate *price
+	switch {
+	case pricing == nil:
+		row["note"] = "no pricing found for this configuration"
+	case paused && pricing.PausedHourlyRate == nil:
+		row["note"] = "no paused pricing found for this configuration"
+	case paused:
+		rate = pricing.PausedHourlyRate
+	default:
+		rate = &pricing.HourlyRate
+	}
+	if rate == nil {
+		g.unpriced++
+		return
+	}
+
+	hourly := float64(*rate)
+	row["currency"] = pricing.Currency
+	row["hourly"] = formatPrice(hourly, 4)
+	row["monthly"] = formatPrice(hourly*hoursPerMonth, 2)
+	if g.totals == nil {
+		g.totals = map[string]float64{}
+	}
+	if _, ok := g.totals[pricing.Currency]; !ok {
+		g.currencies = append(g.currencies, pricing.Currency)
+	}
+	g.totals[pricing.Currency] += hourly
+}
+
+// Returns the rows of the instances followed by a total per currency
+func (g *costGroup) rows(tenantId string) []map[string]any {
+	if len(g.instances) == 0 {
+		return nil
+	}
+	rows := g.instances
+	note := ""
+	if g.unpriced > 0 {
+		note = fmt.Sprintf("excludes %d instances without pricing", g.unpriced)
+	}
+	if len(g.currencies) == 0 {
+		return append(rows, map[string]any{"tenant_id": tenantId, "name": fmt.Sprintf("total %s (%d)", g.label, len(g.instances)), "note": note})
+	}
+	for _, currency := range g.currencies {
+		rows = append(rows, map[string]any{
+			"tenant_id": tenantId,
+			"name":      fmt.Sprintf("total %s (%d)", g.label, len(g.instances)),
+			"currency":  currency,
+			"hourly":    formatPrice(g.totals[currency], 4),
+			"monthly":   formatPrice(g.totals[currency]*hoursPerMonth, 2),
+			"note":      note,
+		})
+	}
+	return rows
+}
+
+// Prints the estimated cost of an instance of the configuration, running and paused
+func printEstimate(cmd *cobra.Command, cfg *clicfg.Config, tenantId string, wanted instanceConfiguration) error {
+	pricing, err := newPricingLookup(cfg).find(tenantId, wanted)
+	if err != nil {
+		return err
+	}
+	if pricing == nil {
+		return clierr.NewUpstreamError("no pricing found for %s instances with %s memory in %s on %s in tenant %s", wanted.Type, wanted.Memory, wanted.Region, wanted.CloudProvider, tenantId)
+	}
+
+	estimate := map[string]any{
+		"tenant_id":      tenantId,
+		"type":           wanted.Type,
+		"cloud_provider": wanted.CloudProvider,
+		"region":         wanted.Region,
+		"memory":         wanted.Memory,
+		"currency":       pricing.Currency,
+		"hourly":         formatPrice(float64(pricing.HourlyRate), 4),
+		"monthly":        formatPrice(float64(pricing.HourlyRate)*hoursPerMonth, 2),
+	}
+	if pricing.PausedHourlyRate != nil {
+		estimate["paused_hourly"] = formatPrice(float64(*pricing.PausedHourlyRate), 4)
+		estimate["paused_monthly"] = formatPrice(float64(*pricing.PausedHourlyRate)*hoursPerMonth, 2)
+	}
+	output.PrintBodyMap(cmd, cfg, api.NewSingleValueResponseData(estimate), []string{"tenant_id", "type", "cloud_provider", "region", "memory", "currency", "hourly", "monthly", "paused_hourly", "paused_monthly"})
+	return nil
+}
+
+func formatPrice(value float64, decimals int) string {
+	return fmt.Sprintf("%.*f", decimals, value)
+}
+
+// Finds the pricing of instance configurations, getting the configurations of each tenant once
+type pricingLookup struct {
+	cfg            *clicfg.Config
+	configurations map[string][]instanceConfiguration
+	// Tenants whose configurations come from the cache and have not been refreshed yet
+	cached map[string]bool
+}
+
+func newPricingLookup(cfg *clicfg.Config) *pricingLookup {
+	return &pricingLookup{cfg: cfg, configurations: map[string][]instanceConfiguration{}, cached: map[string]bool{}}
+}
+
+// Returns the pricing of the configuration of the tenant that matches the type, cloud provider, region and memory, nil when there is none.
+// Cached configurations without a match are refreshed first, as they may be outdated.
+func (l *pricingLookup) find(tenantId string, wanted instanceConfiguration) (*instancePricing, error) {
+	configurations, ok := l.configurations[tenantId]
+	if !ok {
+		var (
+			cached bool
+			err    error
+		)
+		configurations, cached, err = getInstanceConfigurations(l.cfg, tenantId, false)
+		if err != nil {
+			return nil, err
+		}
+		l.configurations[tenantId] = configurations
+		l.cached[tenantId] = cached
+	}
+
+	pricing := matchPricing(configurations, wanted)
+	if pricing == nil && l.cached[tenantId] {
+		refreshed, _, err := getInstanceConfigurations(l.cfg, tenantId, true)
+		if err != nil {
+			return nil, err
+		}
+		l.configurations[tenantId] = refreshed
+		l.cached[tenantId] = false
+		pricing = matchPricing(refreshed, wanted)
+	}
+	return pricing, nil
+}
+
+func matchPricing(configurations []instanceConfiguration, wanted instanceConfiguration) *instancePricing {
+	for _, c := range configurations {
+		if c.Pricing != nil && c.Type == wanted.Type && c.CloudProvider == wanted.CloudProvider && c.Region == wanted.Region && c.Memory == wanted.Memory {
+			return c.Pricing
+		}
+	}
+	return nil
+}
@@ -0,0 +1,143 @@
+// Copyright (c) "Neo4j"
+// Neo4j Sweden AB [http://neo4j.com]
+
+package instance_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/test/testutils"
+)
+
+const tenantWithPricing = `{
+	"data": {
+		"id": "YOUR_TENANT_ID",
+		"name": "Production",
+		"instance_configurations": [
+			{"cloud_provider": "gcp", "memory": "2GB", "region": "europe-west1", "storage": "4GB", "type": "professional-db", "version": "5", "pricing": {"currency": "USD", "hourly_rate": "0.1300", "paused_hourly_rate": "0.0260"}},
+			{"cloud_provider": "gcp", "memory": "8GB", "region": "europe-west1", "storage": "16GB", "type": "professional-db", "version": "5", "pricing": {"currency": "USD", "hourly_rate": 0.52, "paused_hourly_rate": 0.104}},
+			{"cloud_provider": "gcp", "memory": "8GB", "region": "europe-west2", "storage": "16GB", "type": "professional-db", "version": "5"}
+		]
+	}
+}`
+
+func TestInstanceCost(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.SetConfigValue("aura.cache-ttl", "0")
+	helper.NewRequestHandlerMock("GET /v1/instances", http.StatusOK, `{"data": [
+		{"id": "db1d1234", "name": "orders", "tenant_id": "YOUR_TENANT_ID", "cloud_provider": "gcp"},
+		{"id": "db2d1234", "name": "archive", "tenant_id": "YOUR_TENANT_ID", "cloud_provider": "gcp"},
+		{"id": "db3d1234", "name": "search", "tenant_id": "YOUR_TENANT_ID", "cloud_provider": "gcp"},
+		{"id": "db4d1234", "name": "london", "tenant_id": "YOUR_TENANT_ID", "cloud_provider": "gcp"}
+	]}`)
+	helper.NewRequestHandlerMock("GET /v1/instances/db1d1234", http.StatusOK, `{"data": {"id": "db1d1234", "name": "orders", "tenant_id": "YOUR_TENANT_ID", "status": "running", "type": "professional-db", "cloud_provider": "gcp", "region": "europe-west1", "memory": "8GB"}}`)
+	helper.NewRequestHandlerMock("GET /v1/instances/db2d1234", http.StatusOK, `{"data": {"id": "db2d1234", "name": "archive", "tenant_id": "YOUR_TENANT_ID", "status": "paused", "type": "professional-db", "cloud_provider": "gcp", "region": "europe-west1", "memory": "2GB"}}`)
+	helper.NewRequestHandlerMock("GET /v1/instances/db3d1234", http.StatusOK, `{"data": {"id": "db3d1234", "name": "search", "tenant_id": "YOUR_TENANT_ID", "status": "running", "type": "professional-db", "cloud_provider": "gcp", "region": "europe-west1", "memory": "2GB"}}`)
+	helper.NewRequestHandlerMock("GET /v1/instances/db4d1234", http.StatusOK, `{"data": {"id": "db4d1234", "name": "london", "tenant_id": "YOUR_TENANT_ID", "status": "running", "type": "professional-db", "cloud_provider": "gcp", "region": "europe-west2", "memory": "8GB"}}`)
+	tenantMock := helper.NewRequestHandlerMock("GET /v1/tenants/YOUR_TENANT_ID", http.StatusOK, tenantWithPricing)
+
+	helper.ExecuteCommand("instance cost --output table")
+
+	helper.AssertErr("")
+	tenantMock.AssertCalledTimes(1)
+	helper.AssertOut(`
+┌────────────────┬──────────┬───────────────────┬─────────┬─────────────────┬──────────────┬────────┬──────────┬────────┬─────────┬─────────────────────────────────────────┐
+│ TENANT_ID      │ ID       │ NAME              │ STATUS  │ TYPE            │ REGION       │ MEMORY │ CURRENCY │ HOURLY │ MONTHLY │ NOTE                                    │
+├────────────────┼──────────┼───────────────────┼─────────┼─────────────────┼──────────────┼────────┼──────────┼────────┼─────────┼─────────────────────────────────────────┤
+│ YOUR_TENANT_ID │ db4d1234 │ london            │ running │ professional-db │ europe-west2 │ 8GB    │          │        │         │ no pricing found for this configuration │
+│ YOUR_TENANT_ID │ db1d1234 │ orders            │ running │ professional-db │ europe-west1 │ 8GB    │ USD      │ 0.5200 │ 379.60  │                                         │
+│ YOUR_TENANT_ID │ db3d1234 │ search            │ running │ professional-db │ europe-west1 │ 2GB    │ USD      │ 0.1300 │ 94.90   │                                         │
+│ YOUR_TENANT_ID │          │ total running (3) │         │                 │              │        │ USD      │ 0.6500 │ 474.50  │ excludes 1 instances without pricing    │
+│ YOUR_TENANT_ID │ db2d1234 │ archive           │ paused  │ professional-db │ europe-west1 │ 2GB    │ USD      │ 0.0260 │ 18.98   │                                         │
+│ YOUR_TENANT_ID │          │ total paused (1)  │         │                 │              │        │ USD      │ 0.0260 │ 18.98   │                                         │
+└────────────────┴──────────┴───────────────────┴─────────┴─────────────────┴──────────────┴────────┴──────────┴────────┴─────────┴─────────────────────────────────────────┘
+`)
+}
+
+func TestInstanceCostOfGivenInstance(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	instancesMock := helper.NewRequestHandlerMock("GET /v1/instances", http.StatusOK, `{"data": []}`)
+	helper.NewRequestHandlerMock("GET /v1/instances/db1d1234", http.StatusOK, `{"data": {"id": "db1d1234", "name": "orders", "tenant_id": "YOUR_TENANT_ID", "status": "running", "type": "professional-db", "cloud_provider": "gcp", "region": "europe-west1", "memory": "8GB"}}`)
+	helper.NewRequestHandlerMock("GET /v1/tenants/YOUR_TENANT_ID", http.StatusOK, tenantWithPricing)
+
+	helper.ExecuteCommand("instance cost db1d1234")
+
+	helper.AssertErr("")
+	instancesMock.AssertCalledTimes(0)
+	helper.AssertOutJson(`{
+		"data": [
+			{"currency": "USD", "hourly": "0.5200", "id": "db1d1234", "memory": "8GB", "monthly": "379.60", "name": "orders", "region": "europe-west1", "status": "running", "tenant_id": "YOUR_TENANT_ID", "type": "professional-db"},
+			{"currency": "USD", "hourly": "0.5200", "monthly": "379.60", "name": "total running (1)", "note": "", "tenant_id": "YOUR_TENANT_ID"}
+		]
+	}`)
+}
+
+func TestCreateInstanceEstimate(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.NewRequestHandlerMock("GET /v1/tenants/YOUR_TENANT_ID", http.StatusOK, tenantWithPricing)
+	createMock := helper.NewRequestHandlerMock("POST /v1/instances", http.StatusAccepted, `{"data": {}}`)
+
+	helper.ExecuteCommand("instance create --estimate --type professional-db --tenant-id YOUR_TENANT_ID --cloud-provider gcp --region europe-west1 --memory 8GB")
+
+	helper.AssertErr("")
+	createMock.AssertCalledTimes(0)
+	helper.AssertOutJson(`{
+		"data": {
+			"cloud_provider": "gcp",
+			"currency": "USD",
+			"hourly": "0.5200",
+			"memory": "8GB",
+			"monthly": "379.60",
+			"paused_hourly": "0.1040",
+			"paused_monthly": "75.92",
+			"region": "europe-west1",
+			"tenant_id": "YOUR_TENANT_ID",
+			"type": "professional-db"
+		}
+	}`)
+}
+
+func TestCreateInstanceEstimateWithoutPricing(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	// The cached configurations are refreshed when none of them is priced for the instance
+	tenantMock := helper.NewRequestHandlerMock("GET /v1/tenants/YOUR_TENANT_ID", http.StatusOK, tenantWithPricing).AddResponse(http.StatusOK, tenantWithPricing)
+	createMock := helper.NewRequestHandlerMock("POST /v1/instances", http.StatusAccepted, `{"data": {}}`)
+
+	helper.ExecuteCommand("instance create --estimate --name london --type professional-db --tenant-id YOUR_TENANT_ID --cloud-provider gcp --region europe-west2 --memory 8GB")
+
+	tenantMock.AssertCalledTimes(2)
+	createMock.AssertCalledTimes(0)
+	helper.AssertErr("Error: no pricing found for professional-db instances with 8GB memory in europe-west2 on gcp in tenant YOUR_TENANT_ID")
+}
+
+func TestCreateInstanceEstimateWithCreateOnlyFlags(t *testing.T) {
+	tests := map[string]string{
+		"await":              "--await",
+		"save-connection":    "--save-connection",
+		"source-instance-id": "--source-instance-id db1d1234",
+	}
+	for flag, args := range tests {
+		t.Run(flag, func(t *testing.T) {
+			helper := testutils.NewAuraTestHelper(t)
+			defer helper.Close()
+
+			tenantMock := helper.NewRequestHandlerMock("GET /v1/tenants/YOUR_TENANT_ID", http.StatusOK, tenantWithPricing)
+			createMock := helper.NewRequestHandlerMock("POST /v1/instances", http.StatusAccepted, `{"data": {}}`)
+
+			helper.ExecuteCommand("instance create --estimate --type professional-db --tenant-id YOUR_TENANT_ID --cloud-provider gcp --region europe-west1 --memory 8GB " + args)
+
+			tenantMock.AssertCalledTimes(0)
+			createMock.AssertCalledTimes(0)
+			helper.AssertErr(`Error: "--` + flag + `" flag cannot be set together with "--estimate" flag`)
+		})
+	}
+}
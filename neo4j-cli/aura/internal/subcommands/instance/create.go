@@ -8,11 +8,15 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/neo4j/cli/common/clicfg"
+	"github.com/neo4j/cli/common/clicfg/credentials"
+	"github.com/neo4j/cli/common/clierr"
 	"github.com/neo4j/cli/neo4j-cli/aura/internal/api"
 	"github.com/neo4j/cli/neo4j-cli/aura/internal/flags"
 	"github.com/neo4j/cli/neo4j-cli/aura/internal/output"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/subcommands/utils"
 	"github.com/spf13/cobra"
 )
 
@@ -29,6 +33,11 @@ func NewCreateCmd(cfg *clicfg.Config) *cobra.Command {
 		vectorOptimized      bool
 		graphAnalyticsPlugin bool
 		await                bool
+		saveConnection       bool
+		sourceInstanceId     string
+		sourceSnapshotId     string
+		interactive          bool
+		estimate             bool
 	)
 
 	const (
@@ -43,6 +52,11 @@ func NewCreateCmd(cfg *clicfg.Config) *cobra.Command {
 		vectorOptimizedFlag      = "vector-optimized"
 		graphAnalyticsPluginFlag = "graph-analytics-plugin"
 		awaitFlag                = "await"
+		saveConnectionFlag       = "save-connection"
+		sourceInstanceIdFlag     = "source-instance-id"
+		sourceSnapshotIdFlag     = "source-snapshot-id"
+		interactiveFlag          = "interactive"
+		estimateFlag             = "estimate"
 	)
 
 	cmd := &cobra.Command{
@@ -50,7 +64,7 @@ func NewCreateCmd(cfg *clicfg.Config) *cobra.Command {
 		Short: "Creates a new instance",
 		Long: `This subcommand starts the creation process of an Aura instance.
 
-Creating an instance is an asynchronous operation that can be awaited with --await. Supported instance configurations for your tenant can be obtained by calling the tenant get subcommand.
+Creating an instance is an asynchronous operation that can be awaited with --await. Supported instance configurations for your tenant can be obtained by calling the tenant get subcommand. The type, cloud provider, region, version and memory are checked against them before the instance is created, with the configurations cached for the duration of the cache-ttl config value.
 
 You can poll the current status of this operation by periodically getting the instance details for the instance ID using the get subcommand. Once the status transitions from "creating" to "running" you may begin to use your instance.
 
@@ -58,8 +72,31 @@ This subcommand returns your instance ID, initial credentials, connection URL al
 
 You must also provide a --cloud-provider flag with the subcommand, which specifies which cloud provider the instances will be hosted in. The acceptable values for this field are gcp, aws, or azure.
 
-For Enterprise instances you can specify a --customer-managed-key-id flag to use a Customer Managed Key for encryption.`,
+For Enterprise instances you can specify a --customer-managed-key-id flag to use a Customer Managed Key for encryption.
+
+With --save-connection the connection URL and initial credentials are stored in the credentials file, so that the query and env subcommands can use them without passing the password again.
+
+With --source-instance-id the new instance is created from a snapshot of another instance, which mimics the 'Clone to new' functionality of the Aura Console and implies --await. The snapshot is given with --source-snapshot-id and must be exportable, otherwise the latest exportable snapshot of the source instance from the last 7 days is used.
+
+With --interactive the flags that are not set are asked for one by one, offering the tenants available to the current credential and the types, cloud providers, regions, versions and memory sizes of the instance configurations of the chosen tenant. The equivalent command line is printed before the instance is created, so that it can be reused in scripts.
+
+With --estimate the instance is not created, instead the hourly and monthly cost of the instance is estimated from the pricing of the matching instance configuration of the tenant, both while running and while paused. The name is not needed for an estimate, and --await, --save-connection and --source-instance-id cannot be set with it.`,
 		PreRunE: func(cmd *cobra.Command, args []string) error {
+			if interactive {
+				cmd.SilenceUsage = true
+				if err := runCreateWizard(cmd, cfg); err != nil {
+					return err
+				}
+			}
+
+			if estimate {
+				for _, flag := range []string{awaitFlag, saveConnectionFlag, sourceInstanceIdFlag} {
+					if cmd.Flags().Changed(flag) {
+						return fmt.Errorf(`"--%s" flag cannot be set together with "--estimate" flag`, flag)
+					}
+				}
+			}
+
 			if _type != "free-db" {
 				cmd.MarkFlagRequired(memoryFlag)
 				cmd.MarkFlagRequired(regionFlag)
@@ -76,8 +113,12 @@ For Enterprise instances you can specify a --customer-managed-key-id flag to use
 				}
 			}
 
-			if version != "4" && version != "5" {
-				return fmt.Errorf(`invalid argument "%s" for "--version" flag: must be one of "4" or "5"`, version)
+			if sourceSnapshotId != "" && sourceInstanceId == "" {
+				return errors.New(`"--source-snapshot-id" flag can only be set together with "--source-instance-id" flag`)
+			}
+
+			if sourceInstanceId != "" && _type == "free-db" {
+				return errors.New(`"--source-instance-id" flag cannot be set when "--type" flag is set to "free-db"`)
 			}
 
 			if graphAnalyticsPlugin && _type != "professional-db" {
@@ -91,6 +132,10 @@ For Enterprise instances you can specify a --customer-managed-key-id flag to use
 			return nil
 		},
 		RunE: func(cmd *cobra.Command, args []string) error {
+			if !estimate && name == "" {
+				return fmt.Errorf(`required flag(s) "%s" not set`, nameFlag)
+			}
+
 			body := map[string]any{
 				"version":        version,
 				"region":         region,
@@ -99,11 +144,15 @@ For Enterprise instances you can specify a --customer-managed-key-id flag to use
 				"cloud_provider": cloudProvider,
 			}
 
+			cmd.SilenceUsage = true
 			if tenantId == "" {
-				body["tenant_id"] = cfg.Aura.DefaultTenant()
-			} else {
-				body["tenant_id"] = tenantId
+				tenantId = cfg.Aura.DefaultTenant()
+			}
+			resolvedTenantId, err := utils.ResolveTenantId(cfg, tenantId)
+			if err != nil {
+				return err
 			}
+			body["tenant_id"] = resolvedTenantId
 
 			if _type == "free-db" {
 				body["memory"] = "1GB"
@@ -121,10 +170,56 @@ For Enterprise instances you can specify a --customer-managed-key-id flag to use
 			}
 
 			if customerManagedKeyId != "" {
-				body["customer_managed_key_id"] = customerManagedKeyId
+				resolvedCustomerManagedKeyId, err := utils.ResolveCustomerManagedKeyId(cfg, customerManagedKeyId)
+				if err != nil {
+					return err
+				}
+				body["customer_managed_key_id"] = resolvedCustomerManagedKeyId
+			}
+
+			if _type != "free-db" {
+				if err := validateInstanceConfiguration(cfg, resolvedTenantId, instanceConfiguration{
+					Type:          string(_type),
+					CloudProvider: string(cloudProvider),
+					Region:        region,
+					Memory:        string(memory),
+					Version:       version,
+				}); err != nil {
+					return err
+				}
+			}
+
+			if estimate {
+				return printEstimate(cmd, cfg, resolvedTenantId, instanceConfiguration{
+					Type:          string(_type),
+					CloudProvider: fmt.Sprint(body["cloud_provider"]),
+					Region:        fmt.Sprint(body["region"]),
+					Memory:        fmt.Sprint(body["memory"]),
+				})
+			}
+
+			if sourceInstanceId != "" {
+				resolvedSourceInstanceId, err := utils.ResolveInstanceId(cfg, sourceInstanceId)
+				if err != nil {
+					return err
+				}
+
+				if sourceSnapshotId == "" {
+					sourceSnapshotId, err = latestExportableSnapshotId(cfg, resolvedSourceInstanceId)
+					if err != nil {
+						return err
+					}
+					cmd.Printf("Using snapshot %s of instance %s\n", sourceSnapshotId, resolvedSourceInstanceId)
+				} else if err := checkSnapshotExportable(cfg, resolvedSourceInstanceId, sourceSnapshotId); err != nil {
+					return err
+				}
+
+				body["source_instance_id"] = resolvedSourceInstanceId
+				body["source_snapshot_id"] = sourceSnapshotId
+				// The credentials are only returned by this request, so they are printed before waiting for the instance
+				await = true
 			}
 
-			cmd.SilenceUsage = true
 			resBody, statusCode, err := api.MakeRequest(cfg, "/instances", &api.RequestConfig{
 				PostBody: body,
 				Method:   http.MethodPost,
@@ -137,13 +232,23 @@ For Enterprise instances you can specify a --customer-managed-key-id flag to use
 			if statusCode == http.StatusAccepted || statusCode == http.StatusOK {
 				output.PrintBody(cmd, cfg, resBody, []string{"id", "name", "tenant_id", "connection_url", "username", "password", "cloud_provider", "region", "type"})
 
+				var response api.CreateInstanceResponse
+				if err := json.Unmarshal(resBody, &response); err != nil {
+					return err
+				}
+
+				if saveConnection {
+					cfg.Credentials.Connections.Set(&credentials.Connection{
+						InstanceId: response.Data.Id,
+						Uri:        response.Data.ConnectionUrl,
+						Username:   response.Data.Username,
+						Password:   response.Data.Password,
+					})
+					cmd.Println("Saved connection for instance", response.Data.Id)
+				}
+
 				if await {
 					cmd.Println("Waiting for instance to be ready...")
-					var response api.CreateInstanceResponse
-					if err := json.Unmarshal(resBody, &response); err != nil {
-						return err
-					}
-
 					pollResponse, err := api.PollInstance(cfg, response.Data.Id, api.InstanceStatusCreating)
 					if err != nil {
 						return err
@@ -163,8 +268,7 @@ For Enterprise instances you can specify a --customer-managed-key-id flag to use
 
 	cmd.Flags().Var(&memory, memoryFlag, "The size of the instance memory in GB.")
 
-	cmd.Flags().StringVar(&name, nameFlag, "", "(required) The name of the instance (any UTF-8 characters with no trailing or leading whitespace).")
-	cmd.MarkFlagRequired(nameFlag)
+	cmd.Flags().StringVar(&name, nameFlag, "", "(required) The name of the instance (any UTF-8 characters with no trailing or leading whitespace), not needed with --estimate.")
 
 	cmd.Flags().Var(&_type, typeFlag, "(required) The type of the instance.")
 	cmd.MarkFlagRequired(typeFlag)
@@ -181,5 +285,71 @@ For Enterprise instances you can specify a --customer-managed-key-id flag to use
 
 	cmd.Flags().BoolVar(&await, awaitFlag, false, "Waits until created instance is ready.")
 
+	cmd.Flags().BoolVar(&saveConnection, saveConnectionFlag, false, "Stores the connection URL and initial credentials of the instance in the credentials file.")
+
+	cmd.Flags().StringVar(&sourceInstanceId, sourceInstanceIdFlag, "", "The ID of an instance to create the instance from a snapshot of.")
+
+	cmd.Flags().StringVar(&sourceSnapshotId, sourceSnapshotIdFlag, "", "The ID of the exportable snapshot of the source instance to create the instance from, defaults to the latest exportable snapshot.")
+
+	cmd.Flags().BoolVar(&interactive, interactiveFlag, false, "Asks for the instance configuration step by step, offering the choices available to the tenant.")
+
+	cmd.Flags().BoolVar(&estimate, estimateFlag, false, "Estimates the hourly and monthly cost of the instance instead of creating it.")
+
 	return cmd
 }
+
+// How many days back, including today, to look for an exportable snapshot
+const snapshotLookbackDays = 7
+
+// Returns the ID of the most recent completed and exportable snapshot of the instance, looking back day by day as snapshots are listed per day
+func latestExportableSnapshotId(cfg *clicfg.Config, instanceId string) (string, error) {
+	today := time.Now().UTC()
+	for day := range snapshotLookbackDays {
+		resBody, _, err := api.MakeRequest(cfg, fmt.Sprintf("/instances/%s/snapshots", instanceId), &api.RequestConfig{
+			Method:      http.MethodGet,
+			QueryParams: map[string]string{"date": today.AddDate(0, 0, -day).Format(time.DateOnly)},
+		})
+		if err != nil {
+			return "", err
+		}
+
+		var latest map[string]any
+		for _, snapshot := range api.ParseBody(resBody).AsArray() {
+			if !isExportable(snapshot) {
+				continue
+			}
+			if latest == nil || fmt.Sprint(snapshot["timestamp"]) > fmt.Sprint(latest["timestamp"]) {
+				latest = snapshot
+			}
+		}
+		if latest != nil {
+			return fmt.Sprint(latest["snapshot_id"]), nil
+		}
+	}
+
+	return "", clierr.NewUsageError("no exportable snapshot of instance %s was found in the last %d days, create one with the snapshot create subcommand or set --source-snapshot-id", instanceId, snapshotLookbackDays)
+}
+
+func checkSnapshotExportable(cfg *clicfg.Config, instanceId string, snapshotId string) error {
+	resBody, _, err := api.MakeRequest(cfg, fmt.Sprintf("/instances/%s/snapshots/%s", instanceId, snapshotId), &api.RequestConfig{
+		Method: http.MethodGet,
+	})
+	if err != nil {
+		return err
+	}
+	snapshot, err := api.ParseBody(resBody).GetSingleOrError()
+	if err != nil {
+		return err
+	}
+	if snapshot["status"] != api.SnapshotStatusCompleted {
+		return clierr.NewUsageError("snapshot %s of instance %s is not completed, its status is %s", snapshotId, instanceId, snapshot["status"])
+	}
+	if !isExportable(snapshot) {
+		return clierr.NewUsageError("snapshot %s of instance %s is not exportable", snapshotId, instanceId)
+	}
+	return nil
+}
+
+func isExportable(snapshot map[string]any) bool {
+	return snapshot["exportable"] == true && snapshot["status"] == api.SnapshotStatusCompleted
+}
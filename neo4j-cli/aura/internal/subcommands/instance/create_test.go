@@ -7,8 +7,10 @@ import (
 	"fmt"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/neo4j/cli/neo4j-cli/aura/internal/test/testutils"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestCreateFreeInstance(t *testing.T) {
@@ -147,6 +149,20 @@ func TestCreateProfessionalInstanceNoMemory(t *testing.T) {
 `)
 }
 
+func TestCreateProfessionalInstanceNoName(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	mockHandler := helper.NewRequestHandlerMock("/v1/instances", http.StatusOK, "")
+
+	helper.ExecuteCommand("instance create --region europe-west1 --type professional-db --memory 8GB --tenant-id YOUR_TENANT_ID --cloud-provider gcp")
+
+	mockHandler.AssertCalledTimes(0)
+
+	helper.AssertErr(`Error: required flag(s) "name" not set
+`)
+}
+
 func TestCreateProfessionalInstanceNoTenant(t *testing.T) {
 	helper := testutils.NewAuraTestHelper(t)
 	defer helper.Close()
@@ -461,3 +477,119 @@ Waiting for instance to be ready...
 Instance Status: ready
 	`)
 }
+
+func TestCreateInstanceWithSaveConnection(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.NewRequestHandlerMock("/v1/instances", http.StatusAccepted, `{
+			"data": {
+				"id": "db1d1234",
+				"connection_url": "neo4j+s://db1d1234.databases.neo4j.io",
+				"username": "neo4j",
+				"password": "letMeIn123!",
+				"tenant_id": "YOUR_TENANT_ID",
+				"cloud_provider": "gcp",
+				"region": "europe-west1",
+				"type": "free-db",
+				"name": "Instance01"
+			}
+		}`)
+
+	helper.ExecuteCommand("instance create --name Instance01 --type free-db --tenant-id YOUR_TENANT_ID --save-connection")
+
+	helper.AssertErr("")
+	assert.Contains(t, helper.PrintOut(), "Saved connection for instance db1d1234")
+	helper.AssertCredentialsValue("neo4j.connections", `[
+		{
+			"instance-id": "db1d1234",
+			"uri": "neo4j+s://db1d1234.databases.neo4j.io",
+			"username": "neo4j",
+			"password": "letMeIn123!"
+		}
+	]`)
+}
+
+const clonedInstance = `{
+	"data": {
+		"id": "db1d1234",
+		"connection_url": "YOUR_CONNECTION_URL",
+		"username": "neo4j",
+		"password": "letMeIn123!",
+		"tenant_id": "YOUR_TENANT_ID",
+		"cloud_provider": "gcp",
+		"region": "europe-west1",
+		"type": "professional-db",
+		"name": "pr-123"
+	}
+}`
+
+func TestCreateInstanceFromLatestSnapshot(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	today := time.Now().UTC()
+	snapshotsMock := helper.NewRequestHandlerMock("GET /v1/instances/2f49c2b3/snapshots", http.StatusOK, `{"data": []}`).
+		AddResponse(http.StatusOK, `{
+			"data": [
+				{"snapshot_id": "b1a2c3d4-0000-4000-8000-000000000001", "instance_id": "2f49c2b3", "status": "Completed", "exportable": true, "timestamp": "2026-10-18T01:00:00Z"},
+				{"snapshot_id": "b1a2c3d4-0000-4000-8000-000000000002", "instance_id": "2f49c2b3", "status": "Completed", "exportable": true, "timestamp": "2026-10-18T13:00:00Z"},
+				{"snapshot_id": "b1a2c3d4-0000-4000-8000-000000000003", "instance_id": "2f49c2b3", "status": "InProgress", "exportable": false, "timestamp": "2026-10-18T19:00:00Z"}
+			]
+		}`)
+	createMock := helper.NewRequestHandlerMock("POST /v1/instances", http.StatusAccepted, clonedInstance)
+	helper.NewRequestHandlerMock("GET /v1/instances/db1d1234", http.StatusOK, `{"data": {"id": "db1d1234", "status": "creating"}}`).
+		AddResponse(http.StatusOK, `{"data": {"id": "db1d1234", "status": "running"}}`)
+
+	helper.ExecuteCommand("instance create --name pr-123 --type professional-db --tenant-id YOUR_TENANT_ID --cloud-provider gcp --region europe-west1 --memory 4GB --source-instance-id 2f49c2b3 --output table")
+
+	snapshotsMock.AssertCalledTimes(2)
+	snapshotsMock.AssertCalledWithQueryParam("date", today.Format(time.DateOnly))
+	snapshotsMock.AssertCalledWithQueryParam("date", today.AddDate(0, 0, -1).Format(time.DateOnly))
+	createMock.AssertCalledWithBody(`{"cloud_provider":"gcp","memory":"4GB","name":"pr-123","region":"europe-west1","tenant_id":"YOUR_TENANT_ID","type":"professional-db","version":"5","vector_optimized":false,"graph_analytics_plugin":false,"source_instance_id":"2f49c2b3","source_snapshot_id":"b1a2c3d4-0000-4000-8000-000000000002"}`)
+
+	helper.AssertErr("")
+	out := helper.PrintOut()
+	assert.Contains(t, out, "Using snapshot b1a2c3d4-0000-4000-8000-000000000002 of instance 2f49c2b3")
+	assert.Contains(t, out, "letMeIn123!")
+	assert.Contains(t, out, "Waiting for instance to be ready...\nInstance Status: running")
+}
+
+func TestCreateInstanceFromSnapshotNotExportable(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.NewRequestHandlerMock("GET /v1/instances/2f49c2b3/snapshots/b1a2c3d4-0000-4000-8000-000000000001", http.StatusOK, `{
+		"data": {"snapshot_id": "b1a2c3d4-0000-4000-8000-000000000001", "instance_id": "2f49c2b3", "status": "Completed", "exportable": false, "timestamp": "2026-10-18T01:00:00Z"}
+	}`)
+	createMock := helper.NewRequestHandlerMock("POST /v1/instances", http.StatusAccepted, clonedInstance)
+
+	helper.ExecuteCommand("instance create --name pr-123 --type professional-db --tenant-id YOUR_TENANT_ID --cloud-provider gcp --region europe-west1 --memory 4GB --source-instance-id 2f49c2b3 --source-snapshot-id b1a2c3d4-0000-4000-8000-000000000001")
+
+	createMock.AssertCalledTimes(0)
+	helper.AssertErr("Error: snapshot b1a2c3d4-0000-4000-8000-000000000001 of instance 2f49c2b3 is not exportable")
+}
+
+func TestCreateInstanceWithoutExportableSnapshot(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	snapshotsMock := helper.NewRequestHandlerMock("GET /v1/instances/2f49c2b3/snapshots", http.StatusOK, `{"data": []}`)
+	for range 6 {
+		snapshotsMock.AddResponse(http.StatusOK, `{"data": []}`)
+	}
+
+	helper.ExecuteCommand("instance create --name pr-123 --type professional-db --tenant-id YOUR_TENANT_ID --cloud-provider gcp --region europe-west1 --memory 4GB --source-instance-id 2f49c2b3")
+
+	snapshotsMock.AssertCalledTimes(7)
+	helper.AssertErr("Error: no exportable snapshot of instance 2f49c2b3 was found in the last 7 days, create one with the snapshot create subcommand or set --source-snapshot-id")
+}
+
+func TestCreateInstanceWithSnapshotWithoutSourceInstance(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.ExecuteCommand("instance create --name pr-123 --type professional-db --tenant-id YOUR_TENANT_ID --cloud-provider gcp --region europe-west1 --memory 4GB --source-snapshot-id b1a2c3d4-0000-4000-8000-000000000001")
+
+	helper.AssertErr(`Error: "--source-snapshot-id" flag can only be set together with "--source-instance-id" flag`)
+}
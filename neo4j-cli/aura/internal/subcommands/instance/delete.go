@@ -6,26 +6,88 @@ package instance
 import (
 	"fmt"
 	"net/http"
+	"strings"
 
 	"github.com/neo4j/cli/common/clicfg"
 	"github.com/neo4j/cli/neo4j-cli/aura/internal/api"
 	"github.com/neo4j/cli/neo4j-cli/aura/internal/output"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/subcommands/instance/snapshot"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/subcommands/utils"
 	"github.com/spf13/cobra"
 )
 
 func NewDeleteCmd(cfg *clicfg.Config) *cobra.Command {
-	return &cobra.Command{
-		Use:   "delete <id>",
+	var (
+		bulk          bulkFlags
+		await         bool
+		snapshotFirst bool
+		confirmation  utils.ConfirmationFlags
+	)
+
+	const (
+		awaitFlag = "await"
+	)
+
+	cmd := &cobra.Command{
+		Use:   "delete <id> | --all | --selector <key=value>",
 		Short: "Deletes an instance",
 		Long: `Starts the deletion process of an Aura instance.
 
 Deleting an instance is an asynchronous operation. You can poll the current status of this operation by periodically getting the instance details for the instance ID using the get subcommand.
 
-If another operation is being performed on the instance you are trying to delete, an error will be returned that indicates that deletion cannot be performed.`,
-		Args: cobra.ExactArgs(1),
+If another operation is being performed on the instance you are trying to delete, an error will be returned that indicates that deletion cannot be performed.` + utils.ConfirmationHelp + snapshotFirstHelp + bulkHelp,
+		Args: cobra.MaximumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			path := fmt.Sprintf("/instances/%s", args[0])
+			if err := bulk.validateArgs(args); err != nil {
+				return err
+			}
+			if bulk.enabled() {
+				action := DeleteAction()
+				action.confirm = func(rows []map[string]any) error {
+					instances := []string{}
+					for _, row := range rows {
+						instances = append(instances, fmt.Sprintf("%s (%s)", row["name"], row["id"]))
+					}
+					return confirmation.Ask(cmd, fmt.Sprintf("Delete %d instances: %s?", len(rows), strings.Join(instances, ", ")))
+				}
+				action.before = func(cfg *clicfg.Config, instance map[string]any) (string, error) {
+					name, _ := instance["name"].(string)
+					if err := confirmation.CheckProtected(cfg, "instance", fmt.Sprint(instance["id"]), name, "delete"); err != nil {
+						return "", err
+					}
+					if !snapshotFirstEnabled(cmd, cfg, snapshotFirst, instance) {
+						return "", nil
+					}
+					snapshotId, err := snapshot.SafetySnapshot(cfg, fmt.Sprint(instance["id"]), nil)
+					if err != nil {
+						return "", err
+					}
+					return fmt.Sprintf("safety snapshot %s completed", snapshotId), nil
+				}
+				return runBulkAction(cmd, cfg, &bulk, action, await)
+			}
+
 			cmd.SilenceUsage = true
+			instanceId, err := utils.ResolveInstanceId(cfg, args[0])
+			if err != nil {
+				return err
+			}
+
+			if err := confirmation.Confirm(cmd, cfg, utils.DestructiveOperation{
+				Kind:      "instance",
+				Id:        instanceId,
+				Operation: "delete",
+				Question:  fmt.Sprintf("Delete instance %s?", instanceId),
+				Name:      utils.ResourceName(cfg, fmt.Sprintf("/instances/%s", instanceId), api.AuraApiVersion1),
+			}); err != nil {
+				return err
+			}
+
+			if err := runSnapshotFirst(cmd, cfg, snapshotFirst, instanceId, "deleted"); err != nil {
+				return err
+			}
+
+			path := fmt.Sprintf("/instances/%s", instanceId)
 			resBody, statusCode, err := api.MakeRequest(cfg, path, &api.RequestConfig{
 				Method: http.MethodDelete,
 			})
@@ -36,9 +98,42 @@ If another operation is being performed on the instance you are trying to delete
 			// NOTE: Instance delete should not return OK (200), it always returns 202
 			if statusCode == http.StatusAccepted || statusCode == http.StatusOK {
 				output.PrintBody(cmd, cfg, resBody, []string{"id", "name", "tenant_id", "status", "connection_url", "cloud_provider", "region", "type", "memory"})
+
+				if await {
+					cmd.Println("Waiting for instance to be deleted...")
+					if err := api.PollInstanceDeleted(cfg, instanceId); err != nil {
+						return err
+					}
+
+					cmd.Println("Instance deleted")
+				}
 			}
 
 			return nil
 		},
 	}
+
+	addBulkFlags(cmd, &bulk)
+
+	cmd.Flags().BoolVar(&await, awaitFlag, false, "Waits until the instance is deleted.")
+
+	addSnapshotFirstFlag(cmd, &snapshotFirst)
+
+	utils.AddConfirmationFlags(cmd, &confirmation)
+
+	return cmd
+}
+
+func deleteInstance(cfg *clicfg.Config, instanceId string) (string, error) {
+	resBody, _, err := api.MakeRequest(cfg, fmt.Sprintf("/instances/%s", instanceId), &api.RequestConfig{
+		Method: http.MethodDelete,
+	})
+	if err != nil {
+		return "", err
+	}
+	instance, err := api.ParseBody(resBody).GetSingleOrError()
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprint(instance["status"]), nil
 }
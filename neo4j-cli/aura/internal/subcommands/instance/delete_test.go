@@ -9,6 +9,7 @@ import (
 	"testing"
 
 	"github.com/neo4j/cli/neo4j-cli/aura/internal/test/testutils"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestDeleteInstance(t *testing.T) {
@@ -102,3 +103,125 @@ func TestDeleteInstanceError(t *testing.T) {
 		})
 	}
 }
+
+func TestDeleteInstancesWithTenantSelectorAndAwait(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	tenantId := "6f3a1c2e-5b7d-4e8f-9a0b-1c2d3e4f5a6b"
+	listMock := helper.NewRequestHandlerMock("GET /v1/instances", http.StatusOK, fmt.Sprintf(`{
+		"data": [
+			{"id": "2f49c2b3", "name": "dev-api", "tenant_id": "%s", "cloud_provider": "gcp"}
+		]
+	}`, tenantId))
+	helper.NewRequestHandlerMock("GET /v1/instances/2f49c2b3", http.StatusOK, fmt.Sprintf(`{"data": {"id": "2f49c2b3", "name": "dev-api", "tenant_id": "%s", "status": "running"}}`, tenantId)).
+		AddResponse(http.StatusOK, `{"data": {"id": "2f49c2b3", "status": "destroying"}}`).
+		AddResponse(http.StatusNotFound, `{"errors": [{"message": "DB not found: 2f49c2b3", "reason": "db-not-found"}]}`)
+	deleteMock := helper.NewRequestHandlerMock("DELETE /v1/instances/2f49c2b3", http.StatusAccepted, `{"data": {"id": "2f49c2b3", "status": "destroying"}}`)
+
+	helper.ExecuteCommand(fmt.Sprintf("instance delete --selector tenant=%s --await", tenantId))
+
+	listMock.AssertCalledWithQueryParam("tenantId", tenantId)
+	deleteMock.AssertCalledTimes(1)
+	helper.AssertErr("")
+	helper.AssertOutJson(fmt.Sprintf(`{
+		"data": [
+			{"id": "2f49c2b3", "message": "", "name": "dev-api", "result": "succeeded", "status": "deleted", "tenant_id": "%s"}
+		]
+	}`, tenantId))
+}
+
+func TestDeleteInstanceAsksForConfirmation(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	deleteMock := helper.NewRequestHandlerMock("DELETE /v1/instances/2f49c2b3", http.StatusAccepted, `{"data": {"id": "2f49c2b3", "status": "destroying"}}`)
+
+	helper.SetTerminalInput("n\n")
+	helper.ExecuteCommand("instance delete 2f49c2b3")
+
+	deleteMock.AssertCalledTimes(0)
+	helper.AssertOut("Delete instance 2f49c2b3? [y/N]:")
+	helper.AssertErr("Error: the operation was not confirmed")
+}
+
+func TestDeleteInstanceConfirmed(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	deleteMock := helper.NewRequestHandlerMock("DELETE /v1/instances/2f49c2b3", http.StatusAccepted, `{"data": {"id": "2f49c2b3", "status": "destroying"}}`)
+
+	helper.SetTerminalInput("y\n")
+	helper.ExecuteCommand("instance delete 2f49c2b3")
+
+	deleteMock.AssertCalledTimes(1)
+	helper.AssertErr("")
+}
+
+func TestDeleteInstanceWithYesDoesNotAsk(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	deleteMock := helper.NewRequestHandlerMock("DELETE /v1/instances/2f49c2b3", http.StatusAccepted, `{"data": {"id": "2f49c2b3", "status": "destroying"}}`)
+
+	helper.SetTerminalInput("")
+	helper.ExecuteCommand("instance delete 2f49c2b3 --yes")
+
+	deleteMock.AssertCalledTimes(1)
+	helper.AssertErr("")
+	helper.AssertOutJson(`{"data": {"id": "2f49c2b3", "status": "destroying"}}`)
+}
+
+func TestDeleteProtectedInstance(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.SetConfigValue("aura.protected-resources", []string{"^prod-"})
+
+	helper.NewRequestHandlerMock("GET /v1/instances/2f49c2b3", http.StatusOK, `{"data": {"id": "2f49c2b3", "name": "prod-orders"}}`)
+	deleteMock := helper.NewRequestHandlerMock("DELETE /v1/instances/2f49c2b3", http.StatusAccepted, `{"data": {"id": "2f49c2b3", "status": "destroying"}}`)
+
+	helper.ExecuteCommand("instance delete 2f49c2b3")
+
+	deleteMock.AssertCalledTimes(0)
+	helper.AssertErr("Error: instance 2f49c2b3 is protected by the protected-resources config value, use --force to delete it")
+}
+
+func TestDeleteProtectedInstanceWithForce(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.SetConfigValue("aura.protected-resources", []string{"2f49c2b3"})
+
+	deleteMock := helper.NewRequestHandlerMock("DELETE /v1/instances/2f49c2b3", http.StatusAccepted, `{"data": {"id": "2f49c2b3", "status": "destroying"}}`)
+
+	helper.ExecuteCommand("instance delete 2f49c2b3 --force")
+
+	deleteMock.AssertCalledTimes(1)
+	helper.AssertErr("")
+}
+
+func TestDeleteInstancesSkipsProtectedInstances(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.SetConfigValue("aura.protected-resources", []string{"^prod-"})
+
+	helper.NewRequestHandlerMock("GET /v1/instances", http.StatusOK, `{"data": [
+		{"id": "2f49c2b3", "name": "prod-orders", "tenant_id": "YOUR_TENANT_ID"},
+		{"id": "191b0da2", "name": "dev-orders", "tenant_id": "YOUR_TENANT_ID"}
+	]}`)
+	helper.NewRequestHandlerMock("GET /v1/instances/2f49c2b3", http.StatusOK, `{"data": {"id": "2f49c2b3", "name": "prod-orders", "tenant_id": "YOUR_TENANT_ID", "status": "running"}}`)
+	helper.NewRequestHandlerMock("GET /v1/instances/191b0da2", http.StatusOK, `{"data": {"id": "191b0da2", "name": "dev-orders", "tenant_id": "YOUR_TENANT_ID", "status": "running"}}`)
+	prodDeleteMock := helper.NewRequestHandlerMock("DELETE /v1/instances/2f49c2b3", http.StatusAccepted, `{"data": {"id": "2f49c2b3", "status": "destroying"}}`)
+	helper.NewRequestHandlerMock("DELETE /v1/instances/191b0da2", http.StatusAccepted, `{"data": {"id": "191b0da2", "status": "destroying"}}`)
+
+	helper.SetTerminalInput("y\n")
+	helper.ExecuteCommand("instance delete --all --concurrency 1")
+
+	prodDeleteMock.AssertCalledTimes(0)
+	helper.AssertErr("Error: 1 of 2 instances could not be deleted")
+	out := helper.PrintOut()
+	assert.Contains(t, out, "Delete 2 instances: prod-orders (2f49c2b3), dev-orders (191b0da2)? [y/N]: ")
+	assert.Contains(t, out, `"message": "instance 2f49c2b3 is protected by the protected-resources config value, use --force to delete it"`)
+}
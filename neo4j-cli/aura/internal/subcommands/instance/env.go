@@ -0,0 +1,164 @@
+// Copyright (c) "Neo4j"
+// Neo4j Sweden AB [http://neo4j.com]
+
+package instance
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/neo4j/cli/common/clicfg"
+	"github.com/neo4j/cli/common/clicfg/credentials"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/flags"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/subcommands/utils"
+	"github.com/spf13/cobra"
+	"go.yaml.in/yaml/v3"
+)
+
+const UriEnvVar = "NEO4J_URI"
+
+func NewEnvCmd(cfg *clicfg.Config) *cobra.Command {
+	var (
+		format     flags.EnvFormat = "shell"
+		username   string
+		password   string
+		secretName string
+		namespace  string
+	)
+
+	const (
+		formatFlag     = "format"
+		usernameFlag   = "username"
+		passwordFlag   = "password"
+		secretNameFlag = "secret-name"
+		namespaceFlag  = "namespace"
+	)
+
+	cmd := &cobra.Command{
+		Use:   "env <id>",
+		Short: "Prints the connection settings of an instance as environment variables",
+		Long: `This subcommand prints the connection URL, username and password of an instance as the NEO4J_URI, NEO4J_USERNAME and NEO4J_PASSWORD environment variables, in one of the formats:
+  shell   export statements, e.g. eval "$(aura-cli instance env <id>)"
+  dotenv  a .env file
+  json    a JSON object
+  k8s     a Kubernetes Secret manifest, e.g. aura-cli instance env <id> --format k8s | kubectl apply -f -
+
+The password is not returned by the Aura API after an instance is created. It is taken from the --password flag, then from the NEO4J_PASSWORD environment variable, then from the connection saved with instance create --save-connection. The username is taken in the same order and defaults to neo4j.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			instanceId, err := utils.ResolveInstanceId(cfg, args[0])
+			if err != nil {
+				return err
+			}
+
+			connection, err := getConnection(cfg, instanceId, username, password)
+			if err != nil {
+				return err
+			}
+
+			if secretName == "" {
+				secretName = fmt.Sprintf("neo4j-%s", instanceId)
+			}
+
+			rendered, err := renderEnv(format, connection, secretName, namespace)
+			if err != nil {
+				return err
+			}
+
+			cmd.Print(rendered)
+			return nil
+		},
+	}
+
+	cmd.Flags().Var(&format, formatFlag, "The format to print the environment variables in")
+
+	cmd.Flags().StringVar(&username, usernameFlag, "", fmt.Sprintf("The username of the instance, can also be set with the %s environment variable", UsernameEnvVar))
+
+	cmd.Flags().StringVar(&password, passwordFlag, "", fmt.Sprintf("The password of the instance, can also be set with the %s environment variable", PasswordEnvVar))
+
+	cmd.Flags().StringVar(&secretName, secretNameFlag, "", "The name of the Kubernetes Secret, defaults to neo4j-<id>")
+
+	cmd.Flags().StringVar(&namespace, namespaceFlag, "", "The namespace of the Kubernetes Secret")
+
+	return cmd
+}
+
+func renderEnv(format flags.EnvFormat, connection *credentials.Connection, secretName string, namespace string) (string, error) {
+	variables := [][2]string{
+		{UriEnvVar, connection.Uri},
+		{UsernameEnvVar, connection.Username},
+		{PasswordEnvVar, connection.Password},
+	}
+
+	var b strings.Builder
+	switch format {
+	case "dotenv":
+		for _, variable := range variables {
+			fmt.Fprintf(&b, "%s=%s\n", variable[0], dotenvQuote(variable[1]))
+		}
+	case "json":
+		values := map[string]string{}
+		for _, variable := range variables {
+			values[variable[0]] = variable[1]
+		}
+		data, err := json.MarshalIndent(values, "", "\t")
+		if err != nil {
+			return "", err
+		}
+		b.Write(data)
+		b.WriteString("\n")
+	case "k8s":
+		secret := kubernetesSecret{
+			ApiVersion: "v1",
+			Kind:       "Secret",
+			Metadata:   kubernetesMetadata{Name: secretName, Namespace: namespace},
+			Type:       "Opaque",
+			StringData: map[string]string{},
+		}
+		for _, variable := range variables {
+			secret.StringData[variable[0]] = variable[1]
+		}
+		encoder := yaml.NewEncoder(&b)
+		encoder.SetIndent(2)
+		if err := encoder.Encode(secret); err != nil {
+			return "", err
+		}
+	default:
+		for _, variable := range variables {
+			fmt.Fprintf(&b, "export %s=%s\n", variable[0], shellQuote(variable[1]))
+		}
+	}
+
+	return b.String(), nil
+}
+
+type kubernetesSecret struct {
+	ApiVersion string             `yaml:"apiVersion"`
+	Kind       string             `yaml:"kind"`
+	Metadata   kubernetesMetadata `yaml:"metadata"`
+	Type       string             `yaml:"type"`
+	StringData map[string]string  `yaml:"stringData"`
+}
+
+type kubernetesMetadata struct {
+	Name      string `yaml:"name"`
+	Namespace string `yaml:"namespace,omitempty"`
+}
+
+// Single quotes the value, which keeps every character literal in POSIX shells
+func shellQuote(value string) string {
+	return "'" + strings.ReplaceAll(value, "'", `'\''`) + "'"
+}
+
+var dotenvSafe = regexp.MustCompile(`^[A-Za-z0-9_./:@+=-]*$`)
+
+// Leaves values of safe characters unquoted, double quotes and escapes everything else
+func dotenvQuote(value string) string {
+	if dotenvSafe.MatchString(value) {
+		return value
+	}
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`, "$", `\$`).Replace(value) + `"`
+}
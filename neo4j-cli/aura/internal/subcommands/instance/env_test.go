@@ -0,0 +1,120 @@
+// Copyright (c) "Neo4j"
+// Neo4j Sweden AB [http://neo4j.com]
+
+package instance_test
+
+import (
+	"testing"
+
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/test/testutils"
+)
+
+func setStoredConnection(helper *testutils.AuraTestHelper) {
+	helper.SetCredentialsValue("neo4j.connections", []map[string]string{{
+		"instance-id": "2f49c2b3",
+		"uri":         "neo4j+s://2f49c2b3.databases.neo4j.io",
+		"username":    "neo4j",
+		"password":    "it's $ecret",
+	}})
+}
+
+func TestEnvInstanceAsShellExports(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	setStoredConnection(&helper)
+
+	helper.ExecuteCommand("instance env 2f49c2b3")
+
+	helper.AssertErr("")
+	helper.AssertOut(`export NEO4J_URI='neo4j+s://2f49c2b3.databases.neo4j.io'
+export NEO4J_USERNAME='neo4j'
+export NEO4J_PASSWORD='it'\''s $ecret'
+`)
+}
+
+func TestEnvInstanceAsDotenv(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	setStoredConnection(&helper)
+
+	helper.ExecuteCommand("instance env 2f49c2b3 --format dotenv")
+
+	helper.AssertErr("")
+	helper.AssertOut(`NEO4J_URI=neo4j+s://2f49c2b3.databases.neo4j.io
+NEO4J_USERNAME=neo4j
+NEO4J_PASSWORD="it's \$ecret"
+`)
+}
+
+func TestEnvInstanceAsJson(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	setStoredConnection(&helper)
+
+	helper.ExecuteCommand("instance env 2f49c2b3 --format json")
+
+	helper.AssertErr("")
+	helper.AssertOutJson(`{
+		"NEO4J_PASSWORD": "it's $ecret",
+		"NEO4J_URI": "neo4j+s://2f49c2b3.databases.neo4j.io",
+		"NEO4J_USERNAME": "neo4j"
+	}`)
+}
+
+func TestEnvInstanceAsKubernetesSecret(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	setStoredConnection(&helper)
+
+	helper.ExecuteCommand("instance env 2f49c2b3 --format k8s --namespace apps")
+
+	helper.AssertErr("")
+	helper.AssertOut(`apiVersion: v1
+kind: Secret
+metadata:
+  name: neo4j-2f49c2b3
+  namespace: apps
+type: Opaque
+stringData:
+  NEO4J_PASSWORD: it's $ecret
+  NEO4J_URI: neo4j+s://2f49c2b3.databases.neo4j.io
+  NEO4J_USERNAME: neo4j
+`)
+}
+
+func TestEnvInstanceWithPasswordFlag(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	mockInstanceWithConnectionUrl(&helper, "neo4j+s://2f49c2b3.databases.neo4j.io")
+
+	helper.ExecuteCommand("instance env 2f49c2b3 --password secret --format dotenv")
+
+	helper.AssertErr("")
+	helper.AssertOut(`NEO4J_URI=neo4j+s://2f49c2b3.databases.neo4j.io
+NEO4J_USERNAME=neo4j
+NEO4J_PASSWORD=secret
+`)
+}
+
+func TestEnvInstanceWithoutPassword(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.ExecuteCommand("instance env 2f49c2b3")
+
+	helper.AssertErr("Error: no password for instance 2f49c2b3, use the --password flag or the NEO4J_PASSWORD environment variable, or create the instance with --save-connection")
+}
+
+func TestEnvInstanceWithInvalidFormat(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.ExecuteCommand("instance env 2f49c2b3 --format yaml")
+
+	helper.AssertErr(`Error: invalid argument "yaml" for "--format" flag: must be one of "shell", "dotenv", "json", or "k8s"`)
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/neo4j/cli/common/clicfg"
 	"github.com/neo4j/cli/neo4j-cli/aura/internal/api"
 	"github.com/neo4j/cli/neo4j-cli/aura/internal/output"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/subcommands/utils"
 )
 
 func NewGetCmd(cfg *clicfg.Config) *cobra.Command {
@@ -21,10 +22,14 @@ func NewGetCmd(cfg *clicfg.Config) *cobra.Command {
 		Long:  "This endpoint returns details about a specific Aura Instance.",
 		Args:  cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			instanceId := args[0]
+			cmd.SilenceUsage = true
+			instanceId, err := utils.ResolveInstanceId(cfg, args[0])
+			if err != nil {
+				return err
+			}
+
 			path := fmt.Sprintf("/instances/%s", instanceId)
 
-			cmd.SilenceUsage = true
 			resBody, statusCode, err := api.MakeRequest(cfg, path, &api.RequestConfig{
 				Method: http.MethodGet,
 			})
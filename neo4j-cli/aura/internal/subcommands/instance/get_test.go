@@ -218,3 +218,70 @@ func TestUnauthorizedAccessTokenRefresh(t *testing.T) {
 		})
 	}
 }
+
+func TestGetInstanceByName(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	listBody := `{
+		"data": [
+			{"id": "2f49c2b3", "name": "Production", "tenant_id": "YOUR_TENANT_ID", "cloud_provider": "gcp"},
+			{"id": "b51a3e8c", "name": "Staging", "tenant_id": "YOUR_TENANT_ID", "cloud_provider": "gcp"}
+		]
+	}`
+	getBody := `{"data": {"id": "2f49c2b3", "name": "Production"}}`
+	listMock := helper.NewRequestHandlerMock("GET /v1/instances", http.StatusOK, listBody).AddResponse(http.StatusOK, listBody)
+	getMock := helper.NewRequestHandlerMock("GET /v1/instances/2f49c2b3", http.StatusOK, getBody).AddResponse(http.StatusOK, getBody)
+
+	for _, name := range []string{"name:Production", "Production"} {
+		t.Run(name, func(t *testing.T) {
+			helper.ExecuteCommand(fmt.Sprintf("instance get %s", name))
+
+			helper.AssertErr("")
+			helper.AssertOutJson(getBody)
+		})
+	}
+
+	listMock.AssertCalledTimes(2)
+	getMock.AssertCalledTimes(2)
+}
+
+func TestGetInstanceByIdDoesNotListInstances(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	listMock := helper.NewRequestHandlerMock("GET /v1/instances", http.StatusOK, `{"data": []}`)
+	helper.NewRequestHandlerMock("GET /v1/instances/2f49c2b3", http.StatusOK, `{"data": {"id": "2f49c2b3", "name": "Production"}}`)
+
+	helper.ExecuteCommand("instance get 2f49c2b3")
+
+	helper.AssertErr("")
+	listMock.AssertCalledTimes(0)
+}
+
+func TestGetInstanceByAmbiguousName(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.NewRequestHandlerMock("GET /v1/instances", http.StatusOK, `{
+		"data": [
+			{"id": "b51a3e8c", "name": "Production", "tenant_id": "OTHER_TENANT_ID", "cloud_provider": "aws"},
+			{"id": "2f49c2b3", "name": "Production", "tenant_id": "YOUR_TENANT_ID", "cloud_provider": "gcp"}
+		]
+	}`)
+
+	helper.ExecuteCommand("instance get Production")
+
+	helper.AssertErr("Error: the name Production matches more than one instance, use one of the IDs instead: 2f49c2b3, b51a3e8c")
+}
+
+func TestGetInstanceByUnknownName(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.NewRequestHandlerMock("GET /v1/instances", http.StatusOK, `{"data": []}`)
+
+	helper.ExecuteCommand("instance get name:Production")
+
+	helper.AssertErr("Error: no instance named Production was found")
+}
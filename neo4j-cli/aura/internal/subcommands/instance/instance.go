@@ -43,14 +43,20 @@ func NewCmd(cfg *clicfg.Config) *cobra.Command {
 		},
 	}
 
+	cmd.AddCommand(NewCostCmd(cfg))
 	cmd.AddCommand(NewCreateCmd(cfg))
 	cmd.AddCommand(NewDeleteCmd(cfg))
 	cmd.AddCommand(NewGetCmd(cfg))
 	cmd.AddCommand(NewListCmd(cfg))
+	cmd.AddCommand(NewMetricsCmd(cfg))
 	cmd.AddCommand(NewPauseCmd(cfg))
 	cmd.AddCommand(NewResumeCmd(cfg))
 	cmd.AddCommand(NewUpdateCmd(cfg))
 	cmd.AddCommand(NewOverwriteCmd(cfg))
+	cmd.AddCommand(NewQueryCmd(cfg))
+	cmd.AddCommand(NewEnvCmd(cfg))
+	cmd.AddCommand(NewWaitCmd(cfg))
+	cmd.AddCommand(NewWatchCmd(cfg))
 	cmd.AddCommand(snapshot.NewCmd(cfg))
 
 	cmd.PersistentFlags().String("auth-url", "", "")
@@ -9,6 +9,7 @@ import (
 	"github.com/neo4j/cli/common/clicfg"
 	"github.com/neo4j/cli/neo4j-cli/aura/internal/api"
 	"github.com/neo4j/cli/neo4j-cli/aura/internal/output"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/subcommands/utils"
 	"github.com/spf13/cobra"
 )
 
@@ -24,12 +25,16 @@ You can filter instances in a particular tenant using --tenant-id. If the tenant
 		RunE: func(cmd *cobra.Command, args []string) error {
 			path := "/instances"
 
+			cmd.SilenceUsage = true
 			queryParams := make(map[string]string)
 			if tenantId != "" {
-				queryParams["tenantId"] = tenantId
+				resolvedTenantId, err := utils.ResolveTenantId(cfg, tenantId)
+				if err != nil {
+					return err
+				}
+				queryParams["tenantId"] = resolvedTenantId
 			}
 
-			cmd.SilenceUsage = true
 			resBody, statusCode, err := api.MakeRequest(cfg, path, &api.RequestConfig{
 				Method:      http.MethodGet,
 				QueryParams: queryParams,
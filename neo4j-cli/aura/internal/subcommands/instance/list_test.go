@@ -8,6 +8,7 @@ import (
 	"testing"
 
 	"github.com/neo4j/cli/neo4j-cli/aura/internal/test/testutils"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestListInstances(t *testing.T) {
@@ -155,3 +156,69 @@ func TestListCustomerManagedKeysWithInvalidOutput(t *testing.T) {
 
 	helper.AssertErr("Error: invalid output value specified: invalid")
 }
+
+func TestListInstancesWithCredentialOverride(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.SetCredentialsValue("aura.credentials.1", map[string]any{"name": "other", "client-id": "otherclientid", "client-secret": "othersecret", "access-token": "", "token-expiry": 0})
+
+	mockHandler := helper.NewRequestHandlerMock("/v1/instances", http.StatusOK, `{"data": []}`)
+
+	helper.ExecuteCommand("instance list --credential other")
+
+	mockHandler.AssertCalledTimes(1)
+
+	helper.AssertErr("")
+	helper.AssertCredentialsValue("aura.default-credential", "test-cred")
+	helper.AssertCredentialsValue("aura.credentials.0.access-token", "dsa")
+	helper.AssertCredentialsValue("aura.credentials.1.access-token", "<token>")
+}
+
+func TestListInstancesWithCredentialOverrideFromEnvironment(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	t.Setenv("AURA_CREDENTIAL", "other")
+
+	helper.SetCredentialsValue("aura.credentials.1", map[string]any{"name": "other", "client-id": "otherclientid", "client-secret": "othersecret", "access-token": "", "token-expiry": 0})
+
+	mockHandler := helper.NewRequestHandlerMock("/v1/instances", http.StatusOK, `{"data": []}`)
+
+	helper.ExecuteCommand("instance list")
+
+	mockHandler.AssertCalledTimes(1)
+
+	helper.AssertErr("")
+	helper.AssertCredentialsValue("aura.default-credential", "test-cred")
+	helper.AssertCredentialsValue("aura.credentials.1.access-token", "<token>")
+}
+
+func TestListInstancesWithUnknownCredentialOverride(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	mockHandler := helper.NewRequestHandlerMock("/v1/instances", http.StatusOK, `{"data": []}`)
+
+	helper.ExecuteCommand("instance list --credential unknown")
+
+	mockHandler.AssertCalledTimes(0)
+	assert.Contains(t, helper.PrintErr(), `Error: invalid argument "unknown" for "--credential" flag: could not find credential with name unknown`)
+}
+
+func TestListInstancesWithTenantName(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.NewRequestHandlerMock("GET /v1/tenants", http.StatusOK, `{
+		"data": [
+			{"id": "6981ace7-efe8-4f5c-b7c5-267b5162ce91", "name": "Production"}
+		]
+	}`)
+	mockHandler := helper.NewRequestHandlerMock("GET /v1/instances", http.StatusOK, `{"data": []}`)
+
+	helper.ExecuteCommand("instance list --tenant-id name:Production")
+
+	helper.AssertErr("")
+	mockHandler.AssertCalledWithQueryParam("tenantId", "6981ace7-efe8-4f5c-b7c5-267b5162ce91")
+}
@@ -0,0 +1,205 @@
+// Copyright (c) "Neo4j"
+// Neo4j Sweden AB [http://neo4j.com]
+
+package instance
+
+import (
+	"cmp"
+	"fmt"
+	"maps"
+	"net/http"
+	"os"
+	"os/signal"
+	"slices"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/neo4j/cli/common/clicfg"
+	"github.com/neo4j/cli/common/clierr"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/api"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/output"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/prometheus"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/subcommands/tenant"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/subcommands/utils"
+	"github.com/spf13/cobra"
+)
+
+type keyMetric struct {
+	name   string
+	metric string
+}
+
+// The gauges shown by default, in the order they are shown in
+var keyMetrics = []keyMetric{
+	{"cpu_usage", "neo4j_aura_cpu_usage"},
+	{"cpu_limit", "neo4j_aura_cpu_limit"},
+	{"heap_used_ratio", "neo4j_dbms_vm_heap_used_ratio"},
+	{"page_cache_hit_ratio", "neo4j_dbms_page_cache_hit_ratio_per_minute"},
+	{"page_cache_usage_ratio", "neo4j_dbms_page_cache_usage_ratio"},
+	{"store_size_bytes", "neo4j_database_store_size_total"},
+	{"storage_limit_bytes", "neo4j_aura_storage_limit"},
+}
+
+// The label of the metrics integration that identifies the instance a sample is about
+const instanceIdLabel = "instance_id"
+
+func NewMetricsCmd(cfg *clicfg.Config) *cobra.Command {
+	var (
+		all      bool
+		watch    bool
+		interval time.Duration
+	)
+
+	const (
+		allFlag      = "all"
+		watchFlag    = "watch"
+		intervalFlag = "interval"
+	)
+
+	cmd := &cobra.Command{
+		Use:   "metrics <id>",
+		Short: "Returns the current metrics of an instance",
+		Long: `This subcommand scrapes the Prometheus endpoint of the metrics integration of the instance, or of its tenant when the instance has none, authenticating with the access token of the current credential, and returns the samples of the instance.
+
+By default the key gauges are returned: CPU usage and limit, heap usage, page cache hit ratio and usage, store size and storage limit. With --all every metric of the instance is returned under its original name. The remaining labels of each sample, such as the aggregation, are returned with it.
+
+With --watch the metrics are scraped again every --interval until the subcommand is interrupted. The metrics integration updates its metrics about once a minute.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if interval <= 0 {
+				return clierr.NewUsageError(`invalid argument "%s" for "--%s" flag: must be greater than 0`, interval, intervalFlag)
+			}
+
+			cmd.SilenceUsage = true
+			instanceId, err := utils.ResolveInstanceId(cfg, args[0])
+			if err != nil {
+				return err
+			}
+			endpointUrl, err := metricsEndpointUrl(cfg, instanceId)
+			if err != nil {
+				return err
+			}
+
+			ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt, syscall.SIGTERM)
+			defer stop()
+
+			for {
+				rows, err := instanceMetrics(cfg, endpointUrl, instanceId, all)
+				switch {
+				case err != nil && !watch:
+					return err
+				case err != nil:
+					cmd.PrintErrln("Warning:", err)
+				default:
+					if watch && cfg.Aura.Output() != "json" {
+						cmd.Printf("Metrics of instance %s at %s\n", instanceId, time.Now().UTC().Format(time.RFC3339))
+					}
+					output.PrintBodyMap(cmd, cfg, api.NewListResponseData(rows), []string{"metric", "labels", "value"})
+				}
+
+				if !watch {
+					return nil
+				}
+				timer := time.NewTimer(interval)
+				select {
+				case <-ctx.Done():
+					timer.Stop()
+					return nil
+				case <-timer.C:
+				}
+			}
+		},
+	}
+
+	cmd.Flags().BoolVar(&all, allFlag, false, "Returns every metric of the instance instead of the key gauges")
+	cmd.Flags().BoolVar(&watch, watchFlag, false, "Scrapes the metrics again every --interval until interrupted")
+	cmd.Flags().DurationVar(&interval, intervalFlag, time.Minute, "How often to scrape the metrics with --watch")
+
+	return cmd
+}
+
+// Returns the metrics integration endpoint of the instance, falling back to the one of its tenant
+func metricsEndpointUrl(cfg *clicfg.Config, instanceId string) (string, error) {
+	resBody, _, err := api.MakeRequest(cfg, fmt.Sprintf("/instances/%s", instanceId), &api.RequestConfig{
+		Method: http.MethodGet,
+	})
+	if err != nil {
+		return "", err
+	}
+	instance, err := api.ParseBody(resBody).GetSingleOrError()
+	if err != nil {
+		return "", err
+	}
+	if HasMetricsIntegrationEndpointUrl(instance) {
+		return instance["metrics_integration_url"].(string), nil
+	}
+
+	tenantId := fmt.Sprint(instance["tenant_id"])
+	endpointUrl, err := tenant.GetMetricsIntegrationEndpointUrl(cfg, tenantId)
+	if err != nil {
+		return "", err
+	}
+	if endpointUrl == "" {
+		return "", clierr.NewUsageError("no metrics integration is available for instance %s or its tenant %s", instanceId, tenantId)
+	}
+	return endpointUrl, nil
+}
+
+// Scrapes the endpoint and returns a row per sample of the instance, either of the key gauges or of all metrics
+func instanceMetrics(cfg *clicfg.Config, endpointUrl string, instanceId string, all bool) ([]map[string]any, error) {
+	body, err := api.GetMetrics(cfg, endpointUrl, nil)
+	if err != nil {
+		return nil, err
+	}
+	samples, err := prometheus.Parse(body)
+	if err != nil {
+		return nil, clierr.NewUpstreamError("cannot parse the metrics of %s: %w", endpointUrl, err)
+	}
+
+	type row struct {
+		order  int
+		name   string
+		labels string
+		value  float64
+	}
+	rows := []row{}
+	for _, sample := range samples {
+		if sample.Labels[instanceIdLabel] != instanceId {
+			continue
+		}
+		order, name := 0, sample.Name
+		if !all {
+			index := slices.IndexFunc(keyMetrics, func(m keyMetric) bool { return m.metric == sample.Name })
+			if index < 0 {
+				continue
+			}
+			order, name = index, keyMetrics[index].name
+		}
+
+		labels := []string{}
+		for _, label := range slices.Sorted(maps.Keys(sample.Labels)) {
+			if label != instanceIdLabel {
+				labels = append(labels, fmt.Sprintf("%s=%s", label, sample.Labels[label]))
+			}
+		}
+		rows = append(rows, row{order, name, strings.Join(labels, ","), sample.Value})
+	}
+	if len(rows) == 0 {
+		return nil, clierr.NewUpstreamError("no metrics of instance %s were found at %s", instanceId, endpointUrl)
+	}
+
+	slices.SortStableFunc(rows, func(a, b row) int {
+		return cmp.Or(a.order-b.order, strings.Compare(a.name, b.name), strings.Compare(a.labels, b.labels))
+	})
+	result := []map[string]any{}
+	for _, r := range rows {
+		result = append(result, map[string]any{
+			"metric": r.name,
+			"labels": r.labels,
+			"value":  strconv.FormatFloat(r.value, 'f', -1, 64),
+		})
+	}
+	return result, nil
+}
@@ -0,0 +1,109 @@
+// Copyright (c) "Neo4j"
+// Neo4j Sweden AB [http://neo4j.com]
+
+package instance_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/test/testutils"
+)
+
+const instanceMetricsExposition = `# HELP neo4j_aura_cpu_usage CPU usage (cores)
+# TYPE neo4j_aura_cpu_usage gauge
+neo4j_aura_cpu_usage{aggregation="MAX",instance_id="2f49c2b3",instance_mode="PRIMARY"} 0.12 1712345678000
+neo4j_aura_cpu_usage{aggregation="MAX",instance_id="db1d1234"} 0.5
+neo4j_aura_cpu_limit{instance_id="2f49c2b3"} 2
+neo4j_dbms_vm_heap_used_ratio{aggregation="MAX",instance_id="2f49c2b3"} 0.4
+neo4j_database_store_size_total{aggregation="MAX",database="neo4j",instance_id="2f49c2b3"} 1.5e+09
+neo4j_aura_out_of_memory_errors_total{aggregation="SUM",instance_id="2f49c2b3",note="a \"quoted\" value"} 3
+`
+
+func TestInstanceMetrics(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	instanceId := "2f49c2b3"
+	helper.NewRequestHandlerMock(fmt.Sprintf("GET /v1/instances/%s", instanceId), http.StatusOK, fmt.Sprintf(`{
+		"data": {"id": "%s", "name": "Production", "tenant_id": "YOUR_TENANT_ID", "metrics_integration_url": "%s/metrics"}
+	}`, instanceId, helper.Server.URL))
+	metricsMock := helper.NewRequestHandlerMock("GET /metrics", http.StatusOK, instanceMetricsExposition)
+
+	helper.ExecuteCommand(fmt.Sprintf("instance metrics %s --output table", instanceId))
+
+	helper.AssertErr("")
+	metricsMock.AssertCalledTimes(1)
+	helper.AssertOut(`
+┌──────────────────┬───────────────────────────────────────┬────────────┐
+│ METRIC           │ LABELS                                │ VALUE      │
+├──────────────────┼───────────────────────────────────────┼────────────┤
+│ cpu_usage        │ aggregation=MAX,instance_mode=PRIMARY │ 0.12       │
+│ cpu_limit        │                                       │ 2          │
+│ heap_used_ratio  │ aggregation=MAX                       │ 0.4        │
+│ store_size_bytes │ aggregation=MAX,database=neo4j        │ 1500000000 │
+└──────────────────┴───────────────────────────────────────┴────────────┘
+`)
+}
+
+func TestInstanceMetricsAllFromTenantEndpoint(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	instanceId := "2f49c2b3"
+	helper.NewRequestHandlerMock(fmt.Sprintf("GET /v1/instances/%s", instanceId), http.StatusOK, fmt.Sprintf(`{
+		"data": {"id": "%s", "name": "Production", "tenant_id": "YOUR_TENANT_ID", "metrics_integration_url": ""}
+	}`, instanceId))
+	tenantMock := helper.NewRequestHandlerMock("GET /v1/tenants/YOUR_TENANT_ID/metrics-integration", http.StatusOK, fmt.Sprintf(`{
+		"data": {"endpoint": "%s/tenant/metrics"}
+	}`, helper.Server.URL))
+	metricsMock := helper.NewRequestHandlerMock("GET /tenant/metrics", http.StatusOK, instanceMetricsExposition)
+
+	helper.ExecuteCommand(fmt.Sprintf("instance metrics %s --all", instanceId))
+
+	helper.AssertErr("")
+	tenantMock.AssertCalledTimes(1)
+	metricsMock.AssertCalledTimes(1)
+	helper.AssertOutJson(`{
+		"data": [
+			{"labels": "", "metric": "neo4j_aura_cpu_limit", "value": "2"},
+			{"labels": "aggregation=MAX,instance_mode=PRIMARY", "metric": "neo4j_aura_cpu_usage", "value": "0.12"},
+			{"labels": "aggregation=SUM,note=a \"quoted\" value", "metric": "neo4j_aura_out_of_memory_errors_total", "value": "3"},
+			{"labels": "aggregation=MAX,database=neo4j", "metric": "neo4j_database_store_size_total", "value": "1500000000"},
+			{"labels": "aggregation=MAX", "metric": "neo4j_dbms_vm_heap_used_ratio", "value": "0.4"}
+		]
+	}`)
+}
+
+func TestInstanceMetricsWithoutIntegration(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	instanceId := "2f49c2b3"
+	helper.NewRequestHandlerMock(fmt.Sprintf("GET /v1/instances/%s", instanceId), http.StatusOK, fmt.Sprintf(`{
+		"data": {"id": "%s", "name": "Production", "tenant_id": "YOUR_TENANT_ID"}
+	}`, instanceId))
+	helper.NewRequestHandlerMock("GET /v1/tenants/YOUR_TENANT_ID/metrics-integration", http.StatusBadRequest, `{
+		"errors": [{"message": "Metrics integration is not available for this tenant", "reason": "bad-request"}]
+	}`)
+
+	helper.ExecuteCommand(fmt.Sprintf("instance metrics %s", instanceId))
+
+	helper.AssertErr("Error: no metrics integration is available for instance 2f49c2b3 or its tenant YOUR_TENANT_ID")
+}
+
+func TestInstanceMetricsOfUnknownInstance(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	instanceId := "1a2b3c4d"
+	helper.NewRequestHandlerMock(fmt.Sprintf("GET /v1/instances/%s", instanceId), http.StatusOK, fmt.Sprintf(`{
+		"data": {"id": "%s", "name": "Production", "tenant_id": "YOUR_TENANT_ID", "metrics_integration_url": "%s/metrics"}
+	}`, instanceId, helper.Server.URL))
+	helper.NewRequestHandlerMock("GET /metrics", http.StatusOK, instanceMetricsExposition)
+
+	helper.ExecuteCommand(fmt.Sprintf("instance metrics %s", instanceId))
+
+	helper.AssertErr(fmt.Sprintf("Error: no metrics of instance 1a2b3c4d were found at %s/metrics", helper.Server.URL))
+}
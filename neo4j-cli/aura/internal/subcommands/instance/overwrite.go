@@ -10,6 +10,7 @@ import (
 	"github.com/neo4j/cli/common/clicfg"
 	"github.com/neo4j/cli/neo4j-cli/aura/internal/api"
 	"github.com/neo4j/cli/neo4j-cli/aura/internal/output"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/subcommands/utils"
 	"github.com/spf13/cobra"
 )
 
@@ -18,6 +19,7 @@ func NewOverwriteCmd(cfg *clicfg.Config) *cobra.Command {
 		sourceInstanceId string
 		sourceSnapshotId string
 		await            bool
+		snapshotFirst    bool
 	)
 
 	const (
@@ -32,18 +34,29 @@ func NewOverwriteCmd(cfg *clicfg.Config) *cobra.Command {
 
 The overwrite process mimics the 'Clone to existing' functionality of the Aura Console.
 
-If only --source-instance-id is provided, a new snapshot of that instance is created and used for overwriting. Alternatively, you can specify an additional --source-snapshot-id to use a specific snapshot for overwriting, from --source-instance-id provided, otherwise as a snapshot of the instance being overwritten. The snapshot specified must be exportable.
-		`,
+If only --source-instance-id is provided, a new snapshot of that instance is created and used for overwriting. Alternatively, you can specify an additional --source-snapshot-id to use a specific snapshot for overwriting, from --source-instance-id provided, otherwise as a snapshot of the instance being overwritten. The snapshot specified must be exportable.` + snapshotFirstHelp,
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			instanceId := args[0]
-			path := fmt.Sprintf("/instances/%s/overwrite", instanceId)
-
 			cmd.SilenceUsage = true
+			instanceId, err := utils.ResolveInstanceId(cfg, args[0])
+			if err != nil {
+				return err
+			}
+
+			if err := runSnapshotFirst(cmd, cfg, snapshotFirst, instanceId, "overwritten"); err != nil {
+				return err
+			}
+
+			path := fmt.Sprintf("/instances/%s/overwrite", instanceId)
 
 			postBody := make(map[string]any)
 			if sourceInstanceId == "" {
 				sourceInstanceId = instanceId
+			} else {
+				sourceInstanceId, err = utils.ResolveInstanceId(cfg, sourceInstanceId)
+				if err != nil {
+					return err
+				}
 			}
 			postBody["source_instance_id"] = sourceInstanceId
 
@@ -84,5 +97,7 @@ If only --source-instance-id is provided, a new snapshot of that instance is cre
 
 	cmd.Flags().BoolVar(&await, "await", false, "Waits until created snapshot is ready")
 
+	addSnapshotFirstFlag(cmd, &snapshotFirst)
+
 	return cmd
 }
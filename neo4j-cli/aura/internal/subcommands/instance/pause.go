@@ -10,12 +10,22 @@ import (
 	"github.com/neo4j/cli/common/clicfg"
 	"github.com/neo4j/cli/neo4j-cli/aura/internal/api"
 	"github.com/neo4j/cli/neo4j-cli/aura/internal/output"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/subcommands/utils"
 	"github.com/spf13/cobra"
 )
 
 func NewPauseCmd(cfg *clicfg.Config) *cobra.Command {
-	return &cobra.Command{
-		Use:   "pause <id>",
+	var (
+		bulk  bulkFlags
+		await bool
+	)
+
+	const (
+		awaitFlag = "await"
+	)
+
+	cmd := &cobra.Command{
+		Use:   "pause <id> | --all | --selector <key=value>",
 		Short: "Pauses an instance",
 		Long: `Starts the pause process of an Aura instance.
 
@@ -23,12 +33,24 @@ Pausing an instance is an asynchronous operation. You can poll the current statu
 
 The pause time depends on the amount of data stored in the instance; larger quantities of data will take longer. The exact time this will take is dependent on the size of your data store.
 
-If another operation is being performed on the instance you are trying to pause, an error will be returned that indicates that the pause operation cannot be performed.`,
-		Args: cobra.ExactArgs(1),
+If another operation is being performed on the instance you are trying to pause, an error will be returned that indicates that the pause operation cannot be performed.` + bulkHelp + ` Instances that are not running are skipped.`,
+		Args: cobra.MaximumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			path := fmt.Sprintf("/instances/%s/pause", args[0])
+			if err := bulk.validateArgs(args); err != nil {
+				return err
+			}
+			if bulk.enabled() {
+				return runBulkAction(cmd, cfg, &bulk, PauseAction(), await)
+			}
 
 			cmd.SilenceUsage = true
+			instanceId, err := utils.ResolveInstanceId(cfg, args[0])
+			if err != nil {
+				return err
+			}
+
+			path := fmt.Sprintf("/instances/%s/pause", instanceId)
+
 			resBody, statusCode, err := api.MakeRequest(cfg, path, &api.RequestConfig{
 				Method: http.MethodPost,
 			})
@@ -39,8 +61,24 @@ If another operation is being performed on the instance you are trying to pause,
 			// NOTE: Instance pause should not return OK (200), it always returns 202
 			if statusCode == http.StatusAccepted || statusCode == http.StatusOK {
 				output.PrintBody(cmd, cfg, resBody, []string{"id", "name", "status", "tenant_id", "connection_url", "cloud_provider", "region", "type", "memory"})
+
+				if await {
+					cmd.Println("Waiting for instance to be paused...")
+					pollResponse, err := api.PollInstance(cfg, instanceId, api.InstanceStatusPausing)
+					if err != nil {
+						return err
+					}
+
+					cmd.Println("Instance Status:", pollResponse.Data.Status)
+				}
 			}
 			return nil
 		},
 	}
+
+	addBulkFlags(cmd, &bulk)
+
+	cmd.Flags().BoolVar(&await, awaitFlag, false, "Waits until the instance is paused.")
+
+	return cmd
 }
@@ -102,3 +102,107 @@ func TestPauseInstanceError(t *testing.T) {
 		})
 	}
 }
+
+func mockInstancesForBulk(helper *testutils.AuraTestHelper) {
+	helper.NewRequestHandlerMock("GET /v1/instances", http.StatusOK, `{
+		"data": [
+			{"id": "2f49c2b3", "name": "dev-api", "tenant_id": "YOUR_TENANT_ID", "cloud_provider": "gcp"},
+			{"id": "b51f3a2c", "name": "dev-web", "tenant_id": "YOUR_TENANT_ID", "cloud_provider": "gcp"},
+			{"id": "c0ffee12", "name": "dev-old", "tenant_id": "YOUR_TENANT_ID", "cloud_provider": "gcp"},
+			{"id": "d00dfeed", "name": "prod", "tenant_id": "YOUR_TENANT_ID", "cloud_provider": "aws"}
+		]
+	}`)
+	for _, instance := range []struct{ id, name, status, cloudProvider string }{
+		{"2f49c2b3", "dev-api", "running", "gcp"},
+		{"b51f3a2c", "dev-web", "running", "gcp"},
+		{"c0ffee12", "dev-old", "paused", "gcp"},
+		{"d00dfeed", "prod", "running", "aws"},
+	} {
+		helper.NewRequestHandlerMock(fmt.Sprintf("GET /v1/instances/%s", instance.id), http.StatusOK, fmt.Sprintf(`{
+			"data": {
+				"id": "%s",
+				"name": "%s",
+				"status": "%s",
+				"tenant_id": "YOUR_TENANT_ID",
+				"cloud_provider": "%s",
+				"region": "europe-west1",
+				"type": "professional-db"
+			}
+		}`, instance.id, instance.name, instance.status, instance.cloudProvider))
+	}
+}
+
+func TestPauseInstancesWithSelector(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	mockInstancesForBulk(&helper)
+	apiMock := helper.NewRequestHandlerMock("POST /v1/instances/2f49c2b3/pause", http.StatusAccepted, `{"data": {"id": "2f49c2b3", "status": "pausing"}}`)
+	webMock := helper.NewRequestHandlerMock("POST /v1/instances/b51f3a2c/pause", http.StatusAccepted, `{"data": {"id": "b51f3a2c", "status": "pausing"}}`)
+	prodMock := helper.NewRequestHandlerMock("POST /v1/instances/d00dfeed/pause", http.StatusAccepted, `{"data": {"id": "d00dfeed", "status": "pausing"}}`)
+
+	helper.ExecuteCommand("instance pause --selector name=^dev- --selector cloud-provider=gcp")
+
+	apiMock.AssertCalledTimes(1)
+	webMock.AssertCalledTimes(1)
+	prodMock.AssertCalledTimes(0)
+
+	helper.AssertErr("")
+	helper.AssertOutJson(`{
+		"data": [
+			{"id": "2f49c2b3", "message": "", "name": "dev-api", "result": "succeeded", "status": "pausing", "tenant_id": "YOUR_TENANT_ID"},
+			{"id": "b51f3a2c", "message": "", "name": "dev-web", "result": "succeeded", "status": "pausing", "tenant_id": "YOUR_TENANT_ID"},
+			{"id": "c0ffee12", "message": "instance is paused", "name": "dev-old", "result": "skipped", "status": "paused", "tenant_id": "YOUR_TENANT_ID"}
+		]
+	}`)
+}
+
+func TestPauseInstancesWithSelectorAndFailure(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	mockInstancesForBulk(&helper)
+	helper.NewRequestHandlerMock("POST /v1/instances/2f49c2b3/pause", http.StatusAccepted, `{"data": {"id": "2f49c2b3", "status": "pausing"}}`)
+	helper.NewRequestHandlerMock("POST /v1/instances/b51f3a2c/pause", http.StatusConflict, `{"errors": [{"message": "The database is current undergoing an operation: updating", "reason": "ongoing-database-operation"}]}`)
+
+	helper.ExecuteCommand("instance pause --selector name=^dev- --concurrency 1")
+
+	helper.AssertErr("Error: 1 of 3 instances could not be paused")
+	helper.AssertOutJson(`{
+		"data": [
+			{"id": "2f49c2b3", "message": "", "name": "dev-api", "result": "succeeded", "status": "pausing", "tenant_id": "YOUR_TENANT_ID"},
+			{"id": "b51f3a2c", "message": "[The database is current undergoing an operation: updating]", "name": "dev-web", "result": "failed", "status": "running", "tenant_id": "YOUR_TENANT_ID"},
+			{"id": "c0ffee12", "message": "instance is paused", "name": "dev-old", "result": "skipped", "status": "paused", "tenant_id": "YOUR_TENANT_ID"}
+		]
+	}`)
+}
+
+func TestPauseInstancesWithInvalidSelector(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.ExecuteCommand("instance pause --selector memory=8GB")
+
+	helper.AssertErr(`Error: invalid argument "memory=8GB" for "--selector" flag: key must be one of "name", "tenant", "type", "cloud-provider", or "region"`)
+}
+
+func TestPauseInstancesWithTwoTenantsInSelector(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	listMock := helper.NewRequestHandlerMock("GET /v1/instances", http.StatusOK, `{"data": []}`)
+
+	helper.ExecuteCommand("instance pause --selector tenant=YOUR_TENANT_ID --selector tenant=OTHER_TENANT_ID")
+
+	listMock.AssertCalledTimes(0)
+	helper.AssertErr(`Error: invalid argument "tenant=OTHER_TENANT_ID" for "--selector" flag: only one tenant can be selected`)
+}
+
+func TestPauseInstanceWithIdAndAll(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.ExecuteCommand("instance pause 2f49c2b3 --all")
+
+	helper.AssertErr("Error: an instance ID cannot be given together with --all or --selector")
+}
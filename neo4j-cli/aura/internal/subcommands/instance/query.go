@@ -0,0 +1,277 @@
+// Copyright (c) "Neo4j"
+// Neo4j Sweden AB [http://neo4j.com]
+
+package instance
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+
+	"github.com/neo4j/cli/common/clicfg"
+	"github.com/neo4j/cli/common/clicfg/credentials"
+	"github.com/neo4j/cli/common/clierr"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/api"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/output"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/subcommands/utils"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+	"go.yaml.in/yaml/v3"
+)
+
+const (
+	UsernameEnvVar = "NEO4J_USERNAME"
+	PasswordEnvVar = "NEO4J_PASSWORD"
+)
+
+func NewQueryCmd(cfg *clicfg.Config) *cobra.Command {
+	var (
+		database   string
+		username   string
+		password   string
+		params     []string
+		paramsFile string
+	)
+
+	const (
+		databaseFlag   = "database"
+		usernameFlag   = "username"
+		passwordFlag   = "password"
+		paramFlag      = "param"
+		paramsFileFlag = "params-file"
+	)
+
+	cmd := &cobra.Command{
+		Use:   "query <id> <cypher>",
+		Short: "Runs a Cypher query against an instance",
+		Long: `This subcommand runs a Cypher query against a running instance using the Neo4j HTTP Query API, which is derived from the connection URL of the instance.
+
+Query parameters can be set with --param key=value, where values are parsed as JSON when possible, e.g. 42, true or ["a","b"], and used as strings otherwise, and with --params-file, a JSON or YAML file holding a map of parameters. Parameters set with --param take precedence over the ones in the file.
+
+The username and password are taken from the --username and --password flags, then from the NEO4J_USERNAME and NEO4J_PASSWORD environment variables, then from the connection stored for the instance. The username defaults to neo4j.
+
+Each record of the result is printed as a row, with a column for each returned field.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			parameters, err := readQueryParameters(cfg, params, paramsFile)
+			if err != nil {
+				return err
+			}
+
+			cmd.SilenceUsage = true
+			instanceId, err := utils.ResolveInstanceId(cfg, args[0])
+			if err != nil {
+				return err
+			}
+
+			connection, err := getConnection(cfg, instanceId, username, password)
+			if err != nil {
+				return err
+			}
+
+			queryUrl, err := queryApiUrl(connection.Uri, database)
+			if err != nil {
+				return err
+			}
+
+			fields, records, err := runQuery(cfg, queryUrl, connection, args[1], parameters)
+			if err != nil {
+				return err
+			}
+
+			rows := []map[string]any{}
+			for _, record := range records {
+				row := map[string]any{}
+				for i, field := range fields {
+					row[field] = record[i]
+				}
+				rows = append(rows, row)
+			}
+
+			output.PrintBodyMap(cmd, cfg, api.NewListResponseData(rows), fields)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&database, databaseFlag, "neo4j", "The database to run the query against")
+
+	cmd.Flags().StringVar(&username, usernameFlag, "", fmt.Sprintf("The username to authenticate with, can also be set with the %s environment variable", UsernameEnvVar))
+
+	cmd.Flags().StringVar(&password, passwordFlag, "", fmt.Sprintf("The password to authenticate with, can also be set with the %s environment variable", PasswordEnvVar))
+
+	cmd.Flags().StringArrayVar(&params, paramFlag, []string{}, "A query parameter as key=value, can be repeated")
+
+	cmd.Flags().StringVar(&paramsFile, paramsFileFlag, "", "Path to a JSON or YAML file holding a map of query parameters")
+
+	return cmd
+}
+
+func readQueryParameters(cfg *clicfg.Config, params []string, paramsFile string) (map[string]any, error) {
+	parameters := map[string]any{}
+
+	if paramsFile != "" {
+		data, err := afero.ReadFile(cfg.Aura.Fs(), paramsFile)
+		if err != nil {
+			return nil, clierr.NewUsageError("cannot read file %s: %s", paramsFile, err)
+		}
+		// YAML is a superset of JSON, so this reads both
+		if err := yaml.Unmarshal(data, &parameters); err != nil {
+			return nil, clierr.NewUsageError("invalid parameters file %s: %s", paramsFile, err)
+		}
+	}
+
+	for _, param := range params {
+		key, value, ok := strings.Cut(param, "=")
+		if !ok || key == "" {
+			return nil, clierr.NewUsageError(`invalid argument "%s" for "--param" flag: must be of the form key=value`, param)
+		}
+
+		decoder := json.NewDecoder(strings.NewReader(value))
+		decoder.UseNumber()
+		var parsed any
+		if err := decoder.Decode(&parsed); err != nil || decoder.More() {
+			parsed = value
+		}
+		parameters[key] = parsed
+	}
+
+	return parameters, nil
+}
+
+// Returns the connection URL and credentials of the instance, taking flags over environment variables over the stored connection
+func getConnection(cfg *clicfg.Config, instanceId string, username string, password string) (*credentials.Connection, error) {
+	connection := &credentials.Connection{InstanceId: instanceId}
+	if stored, err := cfg.Credentials.Connections.Get(instanceId); err == nil {
+		*connection = *stored
+	}
+
+	if username != "" {
+		connection.Username = username
+	} else if value := os.Getenv(UsernameEnvVar); value != "" {
+		connection.Username = value
+	} else if connection.Username == "" {
+		connection.Username = "neo4j"
+	}
+
+	if password != "" {
+		connection.Password = password
+	} else if value := os.Getenv(PasswordEnvVar); value != "" {
+		connection.Password = value
+	} else if connection.Password == "" {
+		return nil, clierr.NewUsageError("no password for instance %s, use the --password flag or the %s environment variable, or create the instance with --save-connection", instanceId, PasswordEnvVar)
+	}
+
+	if connection.Uri == "" {
+		resBody, _, err := api.MakeRequest(cfg, fmt.Sprintf("/instances/%s", instanceId), &api.RequestConfig{
+			Method: http.MethodGet,
+		})
+		if err != nil {
+			return nil, err
+		}
+		instance, err := api.ParseBody(resBody).GetSingleOrError()
+		if err != nil {
+			return nil, err
+		}
+		connection.Uri, _ = instance["connection_url"].(string)
+	}
+
+	return connection, nil
+}
+
+// Derives the Query API endpoint of the database from the connection URL of an instance, e.g. neo4j+s://2f49c2b3.databases.neo4j.io becomes https://2f49c2b3.databases.neo4j.io/db/neo4j/query/v2
+func queryApiUrl(connectionUrl string, database string) (string, error) {
+	u, err := url.Parse(connectionUrl)
+	if err != nil || u.Host == "" {
+		return "", clierr.NewUpstreamError("invalid connection URL %q", connectionUrl)
+	}
+
+	var scheme string
+	switch u.Scheme {
+	case "neo4j+s", "neo4j+ssc", "bolt+s", "bolt+ssc", "https":
+		scheme = "https"
+	case "neo4j", "bolt", "http":
+		scheme = "http"
+	default:
+		return "", clierr.NewUpstreamError("unsupported scheme %s in connection URL %s", u.Scheme, connectionUrl)
+	}
+
+	host := u.Host
+	// The Bolt port does not serve the Query API
+	if u.Port() == "7687" {
+		host = u.Hostname()
+	}
+
+	queryUrl := url.URL{Scheme: scheme, Host: host}
+	return queryUrl.JoinPath("db", database, "query", "v2").String(), nil
+}
+
+type queryResponse struct {
+	Data struct {
+		Fields []string `json:"fields"`
+		Values [][]any  `json:"values"`
+	} `json:"data"`
+	Errors []struct {
+		Code    string `json:"code"`
+		Message string `json:"message"`
+	} `json:"errors"`
+}
+
+func runQuery(cfg *clicfg.Config, queryUrl string, connection *credentials.Connection, statement string, parameters map[string]any) ([]string, [][]any, error) {
+	body, err := json.Marshal(map[string]any{
+		"statement":  statement,
+		"parameters": parameters,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, queryUrl, bytes.NewReader(body))
+	if err != nil {
+		return nil, nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("User-Agent", api.UserAgent(cfg))
+	req.SetBasicAuth(connection.Username, connection.Password)
+
+	client := http.Client{}
+	res, err := client.Do(req)
+	if err != nil {
+		return nil, nil, clierr.NewUpstreamError("cannot reach %s: %w", queryUrl, err)
+	}
+	defer res.Body.Close()
+
+	resBody, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var response queryResponse
+	decoder := json.NewDecoder(bytes.NewReader(resBody))
+	decoder.UseNumber()
+	if err := decoder.Decode(&response); err != nil {
+		return nil, nil, clierr.NewUpstreamError("unexpected response from %s [status %d]: %s", queryUrl, res.StatusCode, resBody)
+	}
+
+	if len(response.Errors) > 0 {
+		messages := []string{}
+		for _, e := range response.Errors {
+			messages = append(messages, fmt.Sprintf("%s: %s", e.Code, e.Message))
+		}
+		if res.StatusCode == http.StatusUnauthorized || res.StatusCode == http.StatusForbidden {
+			return nil, nil, clierr.NewUsageError("the username or password of instance %s was rejected: %s", connection.InstanceId, strings.Join(messages, ", "))
+		}
+		return nil, nil, clierr.NewUpstreamError("%s", strings.Join(messages, ", "))
+	}
+
+	if !api.IsSuccessful(res.StatusCode) {
+		return nil, nil, clierr.NewUpstreamError("unexpected response from %s [status %d]: %s", queryUrl, res.StatusCode, resBody)
+	}
+
+	return response.Data.Fields, response.Data.Values, nil
+}
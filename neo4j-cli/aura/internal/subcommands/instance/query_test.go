@@ -0,0 +1,230 @@
+// Copyright (c) "Neo4j"
+// Neo4j Sweden AB [http://neo4j.com]
+
+package instance_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/test/testutils"
+	"github.com/stretchr/testify/assert"
+)
+
+type queryRequest struct {
+	Path       string
+	Username   string
+	Password   string
+	Statement  string
+	Parameters map[string]any
+}
+
+// Starts a server standing in for the Query API of an instance, recording the requests it receives
+func newQueryApiServer(t *testing.T, status int, body string) (*httptest.Server, *[]queryRequest) {
+	requests := []queryRequest{}
+	server := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		username, password, _ := req.BasicAuth()
+		request := queryRequest{Path: req.URL.Path, Username: username, Password: password}
+		var reqBody struct {
+			Statement  string         `json:"statement"`
+			Parameters map[string]any `json:"parameters"`
+		}
+		assert.Nil(t, json.NewDecoder(req.Body).Decode(&reqBody))
+		request.Statement = reqBody.Statement
+		request.Parameters = reqBody.Parameters
+		requests = append(requests, request)
+
+		res.WriteHeader(status)
+		res.Write([]byte(body))
+	}))
+	return server, &requests
+}
+
+func mockInstanceWithConnectionUrl(helper *testutils.AuraTestHelper, connectionUrl string) {
+	helper.NewRequestHandlerMock("GET /v1/instances/2f49c2b3", http.StatusOK, fmt.Sprintf(`{
+		"data": {
+			"id": "2f49c2b3",
+			"name": "Production",
+			"status": "running",
+			"connection_url": "%s"
+		}
+	}`, connectionUrl))
+}
+
+const queryResult = `{
+	"data": {
+		"fields": ["name", "age"],
+		"values": [
+			["Alice", 42],
+			["Bob", 37]
+		]
+	},
+	"bookmarks": ["FB:kcwQ"]
+}`
+
+func TestQueryInstance(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	server, requests := newQueryApiServer(t, http.StatusAccepted, queryResult)
+	defer server.Close()
+	mockInstanceWithConnectionUrl(&helper, "neo4j://"+server.Listener.Addr().String())
+
+	helper.ExecuteCommand(`instance query 2f49c2b3 "MATCH (p:Person) WHERE p.age > $age RETURN p.name AS name, p.age AS age" --password secret --param age=30 --param label=Person`)
+
+	helper.AssertErr("")
+	helper.AssertOutJson(`{
+		"data": [
+			{"age": 42, "name": "Alice"},
+			{"age": 37, "name": "Bob"}
+		]
+	}`)
+	assert.Equal(t, []queryRequest{{
+		Path:       "/db/neo4j/query/v2",
+		Username:   "neo4j",
+		Password:   "secret",
+		Statement:  "MATCH (p:Person) WHERE p.age > $age RETURN p.name AS name, p.age AS age",
+		Parameters: map[string]any{"age": float64(30), "label": "Person"},
+	}}, *requests)
+}
+
+func TestQueryInstanceWithTableAndCsvOutput(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	server, _ := newQueryApiServer(t, http.StatusAccepted, queryResult)
+	defer server.Close()
+	helper.NewRequestHandlerMock("GET /v1/instances/2f49c2b3", http.StatusOK, fmt.Sprintf(`{"data": {"id": "2f49c2b3", "connection_url": "neo4j://%s"}}`, server.Listener.Addr())).
+		AddResponse(http.StatusOK, fmt.Sprintf(`{"data": {"id": "2f49c2b3", "connection_url": "neo4j://%s"}}`, server.Listener.Addr()))
+
+	helper.ExecuteCommand(`instance query 2f49c2b3 "RETURN 1" --password secret --output table`)
+
+	helper.AssertErr("")
+	helper.AssertOut(`
+┌───────┬─────┐
+│ NAME  │ AGE │
+├───────┼─────┤
+│ Alice │ 42  │
+│ Bob   │ 37  │
+└───────┴─────┘
+	`)
+
+	helper.ExecuteCommand(`instance query 2f49c2b3 "RETURN 1" --password secret --output csv`)
+
+	helper.AssertErr("")
+	helper.AssertOut(`
+name,age
+Alice,42
+Bob,37
+	`)
+}
+
+func TestQueryInstanceWithParamsFileAndEnvironment(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	server, requests := newQueryApiServer(t, http.StatusAccepted, queryResult)
+	defer server.Close()
+	mockInstanceWithConnectionUrl(&helper, "neo4j://"+server.Listener.Addr().String())
+
+	t.Setenv("NEO4J_USERNAME", "reader")
+	t.Setenv("NEO4J_PASSWORD", "envsecret")
+	helper.SetFile("params.yaml", `
+names:
+  - Alice
+  - Bob
+age: 30
+`)
+
+	helper.ExecuteCommand(`instance query 2f49c2b3 "RETURN 1" --params-file params.yaml --param age=40 --database movies`)
+
+	helper.AssertErr("")
+	assert.Len(t, *requests, 1)
+	request := (*requests)[0]
+	assert.Equal(t, "/db/movies/query/v2", request.Path)
+	assert.Equal(t, "reader", request.Username)
+	assert.Equal(t, "envsecret", request.Password)
+	assert.Equal(t, map[string]any{"names": []any{"Alice", "Bob"}, "age": float64(40)}, request.Parameters)
+}
+
+func TestQueryInstanceWithStoredConnection(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	server, requests := newQueryApiServer(t, http.StatusAccepted, queryResult)
+	defer server.Close()
+
+	helper.SetCredentialsValue("neo4j.connections", []map[string]string{{
+		"instance-id": "2f49c2b3",
+		"uri":         "neo4j://" + server.Listener.Addr().String(),
+		"username":    "neo4j",
+		"password":    "storedsecret",
+	}})
+
+	helper.ExecuteCommand(`instance query 2f49c2b3 "RETURN 1"`)
+
+	helper.AssertErr("")
+	assert.Len(t, *requests, 1)
+	assert.Equal(t, "storedsecret", (*requests)[0].Password)
+}
+
+func TestQueryInstanceWithoutPassword(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.ExecuteCommand(`instance query 2f49c2b3 "RETURN 1"`)
+
+	helper.AssertErr("Error: no password for instance 2f49c2b3, use the --password flag or the NEO4J_PASSWORD environment variable, or create the instance with --save-connection")
+}
+
+func TestQueryInstanceWithInvalidParam(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.ExecuteCommand(`instance query 2f49c2b3 "RETURN 1" --password secret --param age`)
+
+	assert.Contains(t, helper.PrintErr(), `Error: invalid argument "age" for "--param" flag: must be of the form key=value`)
+}
+
+func TestQueryInstanceWithQueryError(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	server, _ := newQueryApiServer(t, http.StatusBadRequest, `{
+		"errors": [
+			{
+				"code": "Neo.ClientError.Statement.SyntaxError",
+				"message": "Invalid input 'RETRN'"
+			}
+		]
+	}`)
+	defer server.Close()
+	mockInstanceWithConnectionUrl(&helper, "neo4j://"+server.Listener.Addr().String())
+
+	helper.ExecuteCommand(`instance query 2f49c2b3 "RETRN 1" --password secret`)
+
+	helper.AssertErr("Error: Neo.ClientError.Statement.SyntaxError: Invalid input 'RETRN'")
+}
+
+func TestQueryInstanceWithWrongPassword(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	server, _ := newQueryApiServer(t, http.StatusUnauthorized, `{
+		"errors": [
+			{
+				"code": "Neo.ClientError.Security.Unauthorized",
+				"message": "The client is unauthorized due to authentication failure."
+			}
+		]
+	}`)
+	defer server.Close()
+	mockInstanceWithConnectionUrl(&helper, "neo4j://"+server.Listener.Addr().String())
+
+	helper.ExecuteCommand(`instance query 2f49c2b3 "RETURN 1" --password wrong`)
+
+	helper.AssertErr("Error: the username or password of instance 2f49c2b3 was rejected: Neo.ClientError.Security.Unauthorized: The client is unauthorized due to authentication failure.")
+}
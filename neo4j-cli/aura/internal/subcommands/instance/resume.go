@@ -11,11 +11,13 @@ import (
 	"github.com/neo4j/cli/common/clicfg"
 	"github.com/neo4j/cli/neo4j-cli/aura/internal/api"
 	"github.com/neo4j/cli/neo4j-cli/aura/internal/output"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/subcommands/utils"
 	"github.com/spf13/cobra"
 )
 
 func NewResumeCmd(cfg *clicfg.Config) *cobra.Command {
 	var (
+		bulk  bulkFlags
 		await bool
 	)
 
@@ -24,18 +26,30 @@ func NewResumeCmd(cfg *clicfg.Config) *cobra.Command {
 	)
 
 	cmd := &cobra.Command{
-		Use:   "resume <id>",
+		Use:   "resume <id> | --all | --selector <key=value>",
 		Short: "Resumes an instance",
 		Long: `Starts the resume process of an Aura instance.
 
 Resuming an instance is an asynchronous operation. You can poll the current status of this operation by periodically getting the instance details for the instance ID using the get subcommand.
 
-If another operation is being performed on the instance you are trying to resume, an error will be returned that indicates that resume cannot be performed.`,
-		Args: cobra.ExactArgs(1),
+If another operation is being performed on the instance you are trying to resume, an error will be returned that indicates that resume cannot be performed.` + bulkHelp + ` Instances that are not paused are skipped.`,
+		Args: cobra.MaximumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			path := fmt.Sprintf("/instances/%s/resume", args[0])
+			if err := bulk.validateArgs(args); err != nil {
+				return err
+			}
+			if bulk.enabled() {
+				return runBulkAction(cmd, cfg, &bulk, ResumeAction(), await)
+			}
 
 			cmd.SilenceUsage = true
+			instanceId, err := utils.ResolveInstanceId(cfg, args[0])
+			if err != nil {
+				return err
+			}
+
+			path := fmt.Sprintf("/instances/%s/resume", instanceId)
+
 			resBody, statusCode, err := api.MakeRequest(cfg, path, &api.RequestConfig{
 				Method: http.MethodPost,
 			})
@@ -66,6 +80,8 @@ If another operation is being performed on the instance you are trying to resume
 		},
 	}
 
+	addBulkFlags(cmd, &bulk)
+
 	cmd.Flags().BoolVar(&await, awaitFlag, false, "Waits until resumed instance is ready.")
 	return cmd
 }
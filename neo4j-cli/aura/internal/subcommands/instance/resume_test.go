@@ -102,3 +102,28 @@ func TestResumeInstanceError(t *testing.T) {
 		})
 	}
 }
+
+func TestResumeAllInstancesWithAwait(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.NewRequestHandlerMock("GET /v1/instances", http.StatusOK, `{
+		"data": [
+			{"id": "2f49c2b3", "name": "dev-api", "tenant_id": "YOUR_TENANT_ID", "cloud_provider": "gcp"}
+		]
+	}`)
+	helper.NewRequestHandlerMock("GET /v1/instances/2f49c2b3", http.StatusOK, `{"data": {"id": "2f49c2b3", "name": "dev-api", "tenant_id": "YOUR_TENANT_ID", "status": "paused"}}`).
+		AddResponse(http.StatusOK, `{"data": {"id": "2f49c2b3", "status": "resuming"}}`).
+		AddResponse(http.StatusOK, `{"data": {"id": "2f49c2b3", "status": "running"}}`)
+	resumeMock := helper.NewRequestHandlerMock("POST /v1/instances/2f49c2b3/resume", http.StatusAccepted, `{"data": {"id": "2f49c2b3", "status": "resuming"}}`)
+
+	helper.ExecuteCommand("instance resume --all --await")
+
+	resumeMock.AssertCalledTimes(1)
+	helper.AssertErr("")
+	helper.AssertOutJson(`{
+		"data": [
+			{"id": "2f49c2b3", "message": "", "name": "dev-api", "result": "succeeded", "status": "running", "tenant_id": "YOUR_TENANT_ID"}
+		]
+	}`)
+}
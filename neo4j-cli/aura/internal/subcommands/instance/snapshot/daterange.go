@@ -0,0 +1,134 @@
+// Copyright (c) "Neo4j"
+// Neo4j Sweden AB [http://neo4j.com]
+
+package snapshot
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/neo4j/cli/common/clicfg"
+	"github.com/neo4j/cli/common/clierr"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/api"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/subcommands/utils"
+	"github.com/spf13/cobra"
+)
+
+const (
+	sinceFlag = "since"
+	untilFlag = "until"
+	lastFlag  = "last"
+)
+
+const (
+	// Snapshots are listed one day at a time, the days of a range are requested at most this many at the same time
+	listConcurrency = 4
+	// Longest range of days that can be listed, as each day is a request
+	maxRangeDays = 366
+)
+
+var lastPattern = regexp.MustCompile(`^([0-9]+)d$`)
+
+type dateRangeFlags struct {
+	since string
+	until string
+	last  string
+}
+
+func addDateRangeFlags(cmd *cobra.Command, flags *dateRangeFlags) {
+	cmd.Flags().StringVar(&flags.since, sinceFlag, "", "The first day to list snapshots for (YYYY-MM-DD)")
+
+	cmd.Flags().StringVar(&flags.until, untilFlag, "", "The last day to list snapshots for (YYYY-MM-DD), defaults to today")
+
+	cmd.Flags().StringVar(&flags.last, lastFlag, "", "The number of days up to today to list snapshots for, e.g. 7d")
+
+	cmd.MarkFlagsMutuallyExclusive(lastFlag, sinceFlag)
+	cmd.MarkFlagsMutuallyExclusive(lastFlag, untilFlag)
+}
+
+func (flags *dateRangeFlags) enabled() bool {
+	return flags.since != "" || flags.until != "" || flags.last != ""
+}
+
+// Returns the days of the range in order, the dates are in UTC like the date query parameter of the snapshots endpoint
+func (flags *dateRangeFlags) days(now time.Time) ([]time.Time, error) {
+	today := now.UTC().Truncate(24 * time.Hour)
+	since, until := today, today
+
+	if flags.last != "" {
+		match := lastPattern.FindStringSubmatch(flags.last)
+		if match == nil {
+			return nil, clierr.NewUsageError(`invalid argument "%s" for "--last" flag: must be a number of days, e.g. 7d`, flags.last)
+		}
+		n, err := strconv.Atoi(match[1])
+		if err != nil || n < 1 {
+			return nil, clierr.NewUsageError(`invalid argument "%s" for "--last" flag: must be at least 1d`, flags.last)
+		}
+		since = today.AddDate(0, 0, -(n - 1))
+	}
+
+	if flags.since != "" {
+		parsed, err := time.Parse(time.DateOnly, flags.since)
+		if err != nil {
+			return nil, clierr.NewUsageError(`invalid argument "%s" for "--since" flag: must be formatted as YYYY-MM-DD`, flags.since)
+		}
+		since = parsed
+	}
+	if flags.until != "" {
+		parsed, err := time.Parse(time.DateOnly, flags.until)
+		if err != nil {
+			return nil, clierr.NewUsageError(`invalid argument "%s" for "--until" flag: must be formatted as YYYY-MM-DD`, flags.until)
+		}
+		until = parsed
+	}
+
+	if until.Before(since) {
+		return nil, clierr.NewUsageError(`invalid argument "%s" for "--until" flag: must not be before %s`, until.Format(time.DateOnly), since.Format(time.DateOnly))
+	}
+
+	days := []time.Time{}
+	for day := since; !day.After(until); day = day.AddDate(0, 0, 1) {
+		days = append(days, day)
+	}
+	if len(days) > maxRangeDays {
+		return nil, clierr.NewUsageError("cannot list snapshots of %d days, the range must be at most %d days", len(days), maxRangeDays)
+	}
+	return days, nil
+}
+
+// Lists the snapshots of the instance for each of the days, requesting several days at the same time, and returns them sorted by timestamp
+func listSnapshots(cfg *clicfg.Config, instanceId string, days []time.Time) ([]map[string]any, error) {
+	perDay := make([][]map[string]any, len(days))
+	errs := make([]error, len(days))
+
+	utils.RunConcurrently(listConcurrency, len(days), func(i int) {
+		resBody, _, err := api.MakeRequest(cfg, fmt.Sprintf("/instances/%s/snapshots", instanceId), &api.RequestConfig{
+			Method:      http.MethodGet,
+			QueryParams: map[string]string{"date": days[i].Format(time.DateOnly)},
+		})
+		if err != nil {
+			errs[i] = err
+			return
+		}
+		perDay[i] = api.ParseBody(resBody).AsArray()
+	})
+
+	snapshots := []map[string]any{}
+	for i := range days {
+		if errs[i] != nil {
+			return nil, errs[i]
+		}
+		snapshots = append(snapshots, perDay[i]...)
+	}
+
+	// RFC 3339 timestamps in UTC sort like strings
+	slices.SortStableFunc(snapshots, func(a, b map[string]any) int {
+		return strings.Compare(fmt.Sprint(a["timestamp"]), fmt.Sprint(b["timestamp"]))
+	})
+	return snapshots, nil
+}
@@ -6,23 +6,53 @@ package snapshot
 import (
 	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/neo4j/cli/common/clicfg"
 	"github.com/neo4j/cli/neo4j-cli/aura/internal/api"
 	"github.com/neo4j/cli/neo4j-cli/aura/internal/output"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/subcommands/utils"
 	"github.com/spf13/cobra"
 )
 
+var snapshotFields = []string{"snapshot_id", "instance_id", "profile", "status", "timestamp"}
+
 func NewListCmd(cfg *clicfg.Config) *cobra.Command {
 	var instanceId string
 	var date string
+	var dateRange dateRangeFlags
 
 	cmd := &cobra.Command{
 		Use:   "list",
 		Short: "Returns a list of snapshots",
-		Long:  `This subcommand returns a list of available snapshots from the current day.`,
+		Long: `This subcommand returns a list of available snapshots from the current day, or from the day given with --date.
+
+Snapshots of several days are listed with --since and --until, or with --last, e.g. --last 7d for today and the 6 days before. The days are requested concurrently and the snapshots of all of them are sorted by timestamp. Days are in UTC.`,
 		RunE: func(cmd *cobra.Command, args []string) error {
+			var days []time.Time
+			if dateRange.enabled() {
+				var err error
+				days, err = dateRange.days(time.Now())
+				if err != nil {
+					return err
+				}
+			}
+
 			cmd.SilenceUsage = true
+			instanceId, err := utils.ResolveInstanceId(cfg, instanceId)
+			if err != nil {
+				return err
+			}
+
+			if days != nil {
+				snapshots, err := listSnapshots(cfg, instanceId, days)
+				if err != nil {
+					return err
+				}
+				output.PrintBodyMap(cmd, cfg, api.NewListResponseData(snapshots), snapshotFields)
+				return nil
+			}
+
 			path := fmt.Sprintf("/instances/%s/snapshots", instanceId)
 			var queryParams map[string]string
 			if date != "" {
@@ -38,7 +68,7 @@ func NewListCmd(cfg *clicfg.Config) *cobra.Command {
 			}
 
 			if statusCode == http.StatusOK {
-				output.PrintBody(cmd, cfg, resBody, []string{"snapshot_id", "instance_id", "profile", "status", "timestamp"})
+				output.PrintBody(cmd, cfg, resBody, snapshotFields)
 			}
 			return nil
 		},
@@ -47,6 +77,10 @@ func NewListCmd(cfg *clicfg.Config) *cobra.Command {
 	cmd.Flags().StringVar(&instanceId, "instance-id", "", "The ID of the instance to list the snapshots of")
 	cmd.MarkFlagRequired("instance-id")
 	cmd.Flags().StringVar(&date, "date", "", "An optional date to list snapshots for a given day, defaults to today. Must be formatted with an ISO formatted date string (YYYY-MM-DD)")
+	addDateRangeFlags(cmd, &dateRange)
+	cmd.MarkFlagsMutuallyExclusive("date", sinceFlag)
+	cmd.MarkFlagsMutuallyExclusive("date", untilFlag)
+	cmd.MarkFlagsMutuallyExclusive("date", lastFlag)
 
 	return cmd
 }
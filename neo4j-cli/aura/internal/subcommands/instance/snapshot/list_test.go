@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/neo4j/cli/neo4j-cli/aura/internal/test/testutils"
 )
@@ -85,3 +86,68 @@ func TestListSnapshotWithDate(t *testing.T) {
 	}
 	`)
 }
+
+func TestListSnapshotSinceUntil(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	// The days are requested concurrently, so the responses are not in the order of the days
+	mockHandler := helper.NewRequestHandlerMock("GET /v1/instances/2f49c2b3/snapshots", http.StatusOK, `{"data": [
+			{"instance_id": "2f49c2b3", "profile": "Scheduled", "snapshot_id": "snap2", "status": "Completed", "timestamp": "2024-09-13T02:00:00Z"}
+		]}`).
+		AddResponse(http.StatusOK, `{"data": [
+			{"instance_id": "2f49c2b3", "profile": "AdHoc", "snapshot_id": "snap4", "status": "Completed", "timestamp": "2024-09-14T13:00:00Z"},
+			{"instance_id": "2f49c2b3", "profile": "Scheduled", "snapshot_id": "snap3", "status": "Failed", "timestamp": "2024-09-14T02:00:00Z"}
+		]}`).
+		AddResponse(http.StatusOK, `{"data": [
+			{"instance_id": "2f49c2b3", "profile": "Scheduled", "snapshot_id": "snap1", "status": "Completed", "timestamp": "2024-09-12T02:00:00Z"}
+		]}`)
+
+	helper.ExecuteCommand("instance snapshot list --instance-id 2f49c2b3 --since 2024-09-12 --until 2024-09-14")
+
+	mockHandler.AssertCalledTimes(3)
+	mockHandler.AssertCalledWithQueryParam("date", "2024-09-12")
+	mockHandler.AssertCalledWithQueryParam("date", "2024-09-13")
+	mockHandler.AssertCalledWithQueryParam("date", "2024-09-14")
+
+	helper.AssertErr("")
+	helper.AssertOutJson(`{
+		"data": [
+			{"instance_id": "2f49c2b3", "profile": "Scheduled", "snapshot_id": "snap1", "status": "Completed", "timestamp": "2024-09-12T02:00:00Z"},
+			{"instance_id": "2f49c2b3", "profile": "Scheduled", "snapshot_id": "snap2", "status": "Completed", "timestamp": "2024-09-13T02:00:00Z"},
+			{"instance_id": "2f49c2b3", "profile": "Scheduled", "snapshot_id": "snap3", "status": "Failed", "timestamp": "2024-09-14T02:00:00Z"},
+			{"instance_id": "2f49c2b3", "profile": "AdHoc", "snapshot_id": "snap4", "status": "Completed", "timestamp": "2024-09-14T13:00:00Z"}
+		]
+	}`)
+}
+
+func TestListSnapshotLast(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	mockHandler := helper.NewRequestHandlerMock("GET /v1/instances/2f49c2b3/snapshots", http.StatusOK, `{"data": []}`).
+		AddResponse(http.StatusOK, `{"data": []}`)
+
+	helper.ExecuteCommand("instance snapshot list --instance-id 2f49c2b3 --last 2d")
+
+	mockHandler.AssertCalledTimes(2)
+	mockHandler.AssertCalledWithQueryParam("date", time.Now().UTC().Format(time.DateOnly))
+	mockHandler.AssertCalledWithQueryParam("date", time.Now().UTC().AddDate(0, 0, -1).Format(time.DateOnly))
+	helper.AssertOutJson(`{"data": []}`)
+}
+
+func TestListSnapshotWithInvalidRange(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.ExecuteCommand("instance snapshot list --instance-id 2f49c2b3 --since 2024-09-14 --until 2024-09-12")
+	helper.AssertErr(`Error: invalid argument "2024-09-12" for "--until" flag: must not be before 2024-09-14`)
+}
+
+func TestListSnapshotWithInvalidLast(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.ExecuteCommand("instance snapshot list --instance-id 2f49c2b3 --last 7")
+	helper.AssertErr(`Error: invalid argument "7" for "--last" flag: must be a number of days, e.g. 7d`)
+}
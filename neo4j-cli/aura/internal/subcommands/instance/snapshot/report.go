@@ -0,0 +1,157 @@
+// Copyright (c) "Neo4j"
+// Neo4j Sweden AB [http://neo4j.com]
+
+package snapshot
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/neo4j/cli/common/clicfg"
+	"github.com/neo4j/cli/common/clierr"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/api"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/output"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/subcommands/utils"
+	"github.com/spf13/cobra"
+)
+
+const snapshotProfileScheduled = "Scheduled"
+
+func NewReportCmd(cfg *clicfg.Config) *cobra.Command {
+	var (
+		instanceId string
+		dateRange  dateRangeFlags
+		interval   time.Duration
+	)
+
+	const (
+		instanceIdFlag = "instance-id"
+		intervalFlag   = "interval"
+	)
+
+	cmd := &cobra.Command{
+		Use:   "report",
+		Short: "Reports the snapshot coverage of an instance per day",
+		Long: `This subcommand reports on the snapshots of an instance for each day of a range, which defaults to the last 7 days. The range is set like for the list subcommand, with --since and --until or with --last. Days are in UTC.
+
+For each day the number of snapshots, completed snapshots and failed snapshots are shown, along with the latest completed snapshot of the day.
+
+A day has a gap when fewer scheduled snapshots completed than expected from --interval, which is the interval at which Aura takes scheduled snapshots of the instance, or when a scheduled snapshot failed. The command exits with a non-zero status when any day has a gap.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if !dateRange.enabled() {
+				dateRange.last = "7d"
+			}
+			now := time.Now()
+			days, err := dateRange.days(now)
+			if err != nil {
+				return err
+			}
+			if interval <= 0 {
+				return clierr.NewUsageError(`invalid argument "%s" for "--interval" flag: must be greater than 0`, interval)
+			}
+
+			cmd.SilenceUsage = true
+			instanceId, err := utils.ResolveInstanceId(cfg, instanceId)
+			if err != nil {
+				return err
+			}
+
+			snapshots, err := listSnapshots(cfg, instanceId, days)
+			if err != nil {
+				return err
+			}
+
+			rows := snapshotReport(days, snapshots, interval, now)
+			output.PrintBodyMap(cmd, cfg, api.NewListResponseData(rows), []string{"date", "snapshots", "completed", "failed", "latest_completed_id", "latest_completed_at", "gap"})
+
+			gaps := 0
+			for _, row := range rows {
+				if row["gap"] != "" {
+					gaps++
+				}
+			}
+			if gaps > 0 {
+				return clierr.NewUpstreamError("%d of %d days have missing or failed scheduled snapshots", gaps, len(rows))
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&instanceId, instanceIdFlag, "", "(required) The ID of the instance to report on")
+	cmd.MarkFlagRequired(instanceIdFlag)
+
+	addDateRangeFlags(cmd, &dateRange)
+
+	cmd.Flags().DurationVar(&interval, intervalFlag, 24*time.Hour, "The interval of the scheduled snapshots of the instance, e.g. 1h for hourly snapshots")
+
+	return cmd
+}
+
+// Returns a row for each day with the counts of its snapshots, its latest completed snapshot and a description of its gap, which is empty when there is none.
+// Snapshots are attributed to the UTC day of their timestamp, the current day only expects the scheduled snapshots due so far.
+func snapshotReport(days []time.Time, snapshots []map[string]any, interval time.Duration, now time.Time) []map[string]any {
+	rows := []map[string]any{}
+	for _, day := range days {
+		date := day.Format(time.DateOnly)
+		row := map[string]any{
+			"date":                date,
+			"snapshots":           0,
+			"completed":           0,
+			"failed":              0,
+			"latest_completed_id": "",
+			"latest_completed_at": "",
+			"gap":                 "",
+		}
+
+		scheduledCompleted := 0
+		scheduledFailed := 0
+		// The snapshots are sorted by timestamp, so the last completed one is the latest
+		for _, snapshot := range snapshots {
+			timestamp := fmt.Sprint(snapshot["timestamp"])
+			if snapshotDate(timestamp) != date {
+				continue
+			}
+			row["snapshots"] = row["snapshots"].(int) + 1
+
+			scheduled := snapshot["profile"] == snapshotProfileScheduled
+			switch snapshot["status"] {
+			case api.SnapshotStatusCompleted:
+				row["completed"] = row["completed"].(int) + 1
+				row["latest_completed_id"] = fmt.Sprint(snapshot["snapshot_id"])
+				row["latest_completed_at"] = timestamp
+				if scheduled {
+					scheduledCompleted++
+				}
+			case api.SnapshotStatusFailed:
+				row["failed"] = row["failed"].(int) + 1
+				if scheduled {
+					scheduledFailed++
+				}
+			}
+		}
+
+		elapsed := min(max(now.Sub(day), 0), 24*time.Hour)
+		expected := int(elapsed / interval)
+
+		gaps := []string{}
+		if scheduledCompleted < expected {
+			gaps = append(gaps, fmt.Sprintf("%d of %d scheduled snapshots missing", expected-scheduledCompleted, expected))
+		}
+		if scheduledFailed > 0 {
+			gaps = append(gaps, fmt.Sprintf("%d scheduled snapshots failed", scheduledFailed))
+		}
+		row["gap"] = strings.Join(gaps, ", ")
+
+		rows = append(rows, row)
+	}
+	return rows
+}
+
+func snapshotDate(timestamp string) string {
+	t, err := time.Parse(time.RFC3339, timestamp)
+	if err != nil {
+		return ""
+	}
+	return t.UTC().Format(time.DateOnly)
+}
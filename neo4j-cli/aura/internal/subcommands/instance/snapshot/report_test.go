@@ -0,0 +1,55 @@
+// Copyright (c) "Neo4j"
+// Neo4j Sweden AB [http://neo4j.com]
+
+package snapshot_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/test/testutils"
+)
+
+func TestSnapshotReport(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.NewRequestHandlerMock("GET /v1/instances/2f49c2b3/snapshots", http.StatusOK, `{"data": [
+			{"instance_id": "2f49c2b3", "profile": "Scheduled", "snapshot_id": "snap1", "status": "Completed", "timestamp": "2024-09-12T02:00:00Z"},
+			{"instance_id": "2f49c2b3", "profile": "AdHoc", "snapshot_id": "snap2", "status": "Completed", "timestamp": "2024-09-12T13:00:00Z"}
+		]}`).
+		AddResponse(http.StatusOK, `{"data": []}`).
+		AddResponse(http.StatusOK, `{"data": [
+			{"instance_id": "2f49c2b3", "profile": "Scheduled", "snapshot_id": "snap3", "status": "Failed", "timestamp": "2024-09-14T02:00:00Z"},
+			{"instance_id": "2f49c2b3", "profile": "AdHoc", "snapshot_id": "snap4", "status": "Completed", "timestamp": "2024-09-14T13:00:00Z"}
+		]}`)
+
+	helper.ExecuteCommand("instance snapshot report --instance-id 2f49c2b3 --since 2024-09-12 --until 2024-09-14")
+
+	helper.AssertErr("Error: 2 of 3 days have missing or failed scheduled snapshots")
+	helper.AssertOutJson(`{
+		"data": [
+			{"completed": 2, "date": "2024-09-12", "failed": 0, "gap": "", "latest_completed_at": "2024-09-12T13:00:00Z", "latest_completed_id": "snap2", "snapshots": 2},
+			{"completed": 0, "date": "2024-09-13", "failed": 0, "gap": "1 of 1 scheduled snapshots missing", "latest_completed_at": "", "latest_completed_id": "", "snapshots": 0},
+			{"completed": 1, "date": "2024-09-14", "failed": 1, "gap": "1 of 1 scheduled snapshots missing, 1 scheduled snapshots failed", "latest_completed_at": "2024-09-14T13:00:00Z", "latest_completed_id": "snap4", "snapshots": 2}
+		]
+	}`)
+}
+
+func TestSnapshotReportHourly(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.NewRequestHandlerMock("GET /v1/instances/2f49c2b3/snapshots", http.StatusOK, `{"data": [
+			{"instance_id": "2f49c2b3", "profile": "Scheduled", "snapshot_id": "snap1", "status": "Completed", "timestamp": "2024-09-12T02:00:00Z"}
+		]}`)
+
+	helper.ExecuteCommand("instance snapshot report --instance-id 2f49c2b3 --since 2024-09-12 --until 2024-09-12 --interval 1h --output table")
+
+	helper.AssertErr("Error: 1 of 1 days have missing or failed scheduled snapshots")
+	helper.AssertOut(`┌────────────┬───────────┬───────────┬────────┬─────────────────────┬──────────────────────┬──────────────────────────────────────┐
+│ DATE       │ SNAPSHOTS │ COMPLETED │ FAILED │ LATEST_COMPLETED_ID │ LATEST_COMPLETED_AT  │ GAP                                  │
+├────────────┼───────────┼───────────┼────────┼─────────────────────┼──────────────────────┼──────────────────────────────────────┤
+│ 2024-09-12 │ 1         │ 1         │ 0      │ snap1               │ 2024-09-12T02:00:00Z │ 23 of 24 scheduled snapshots missing │
+└────────────┴───────────┴───────────┴────────┴─────────────────────┴──────────────────────┴──────────────────────────────────────┘`)
+}
@@ -0,0 +1,147 @@
+// Copyright (c) "Neo4j"
+// Neo4j Sweden AB [http://neo4j.com]
+
+package snapshot
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/neo4j/cli/common/clicfg"
+	"github.com/neo4j/cli/common/clierr"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/api"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/output"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/subcommands/utils"
+	"github.com/spf13/cobra"
+)
+
+func NewRestoreCmd(cfg *clicfg.Config) *cobra.Command {
+	var (
+		instanceId     string
+		snapshotId     string
+		await          bool
+		safetySnapshot bool
+		confirmation   utils.ConfirmationFlags
+	)
+
+	const (
+		instanceIdFlag     = "instance-id"
+		snapshotIdFlag     = "snapshot-id"
+		awaitFlag          = "await"
+		safetySnapshotFlag = "safety-snapshot"
+	)
+
+	cmd := &cobra.Command{
+		Use:   "restore",
+		Short: "Restores an instance to one of its snapshots",
+		Long: `This subcommand restores an Aura instance to one of its own snapshots, replacing all of its current data with the data of the snapshot.
+
+Restoring is an asynchronous operation that can be awaited with --await, which waits until the status of the instance is no longer "restoring".
+
+With --safety-snapshot a snapshot of the current data is taken first, and the restore only starts once that snapshot is completed, so that it can be restored again if needed.` + utils.ConfirmationHelp,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			resolvedInstanceId, err := utils.ResolveInstanceId(cfg, instanceId)
+			if err != nil {
+				return err
+			}
+
+			if err := confirmation.Confirm(cmd, cfg, utils.DestructiveOperation{
+				Kind:      "instance",
+				Id:        resolvedInstanceId,
+				Operation: "restore",
+				Question:  fmt.Sprintf("Restore instance %s to snapshot %s? The current data of the instance will be replaced", resolvedInstanceId, snapshotId),
+				Name:      utils.ResourceName(cfg, fmt.Sprintf("/instances/%s", resolvedInstanceId), api.AuraApiVersion1),
+			}); err != nil {
+				return err
+			}
+
+			if safetySnapshot {
+				if err := TakeSafetySnapshot(cmd, cfg, resolvedInstanceId, "restored"); err != nil {
+					return err
+				}
+			}
+
+			path := fmt.Sprintf("/instances/%s/snapshots/%s/restore", resolvedInstanceId, snapshotId)
+			resBody, statusCode, err := api.MakeRequest(cfg, path, &api.RequestConfig{
+				Method: http.MethodPost,
+			})
+			if err != nil {
+				return err
+			}
+
+			if statusCode == http.StatusAccepted || statusCode == http.StatusOK {
+				output.PrintBody(cmd, cfg, resBody, []string{"id", "name", "tenant_id", "status", "connection_url", "cloud_provider", "region", "type", "memory"})
+
+				if await {
+					cmd.Println("Waiting for instance to be restored...")
+					pollResponse, err := api.PollInstance(cfg, resolvedInstanceId, api.InstanceStatusRestoring)
+					if err != nil {
+						return err
+					}
+
+					cmd.Println("Instance Status:", pollResponse.Data.Status)
+				}
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&instanceId, instanceIdFlag, "", "(required) The ID of the instance to restore")
+	cmd.MarkFlagRequired(instanceIdFlag)
+
+	cmd.Flags().StringVar(&snapshotId, snapshotIdFlag, "", "(required) The ID of the snapshot of the instance to restore")
+	cmd.MarkFlagRequired(snapshotIdFlag)
+
+	cmd.Flags().BoolVar(&await, awaitFlag, false, "Waits until the instance is restored.")
+
+	cmd.Flags().BoolVar(&safetySnapshot, safetySnapshotFlag, false, "Takes a snapshot of the current data of the instance and waits for it to complete before restoring.")
+
+	utils.AddConfirmationFlags(cmd, &confirmation)
+
+	return cmd
+}
+
+// Takes a snapshot of the instance and waits for it to complete, so that the data replaced or removed by a following operation can be recovered.
+// Calls onCreated with the ID of the snapshot before waiting, and returns the ID once the snapshot is completed.
+func SafetySnapshot(cfg *clicfg.Config, instanceId string, onCreated func(snapshotId string)) (string, error) {
+	resBody, _, err := api.MakeRequest(cfg, fmt.Sprintf("/instances/%s/snapshots", instanceId), &api.RequestConfig{
+		Method: http.MethodPost,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	var response api.CreateSnapshotResponse
+	if err := json.Unmarshal(resBody, &response); err != nil {
+		return "", err
+	}
+	snapshotId := response.Data.SnapshotId
+
+	if onCreated != nil {
+		onCreated(snapshotId)
+	}
+	pollResponse, err := api.PollSnapshot(cfg, instanceId, snapshotId)
+	if err != nil {
+		return "", err
+	}
+	if pollResponse.Data.Status != api.SnapshotStatusCompleted {
+		return "", clierr.NewUpstreamError("safety snapshot %s of instance %s did not complete, its status is %s", snapshotId, instanceId, pollResponse.Data.Status)
+	}
+
+	return snapshotId, nil
+}
+
+// Takes a safety snapshot and prints its progress, the error names the operation that was not performed, e.g. restored
+func TakeSafetySnapshot(cmd *cobra.Command, cfg *clicfg.Config, instanceId string, operation string) error {
+	snapshotId, err := SafetySnapshot(cfg, instanceId, func(snapshotId string) {
+		cmd.Printf("Waiting for safety snapshot %s to be ready...\n", snapshotId)
+	})
+	if err != nil {
+		return clierr.NewUpstreamError("%w, the instance was not %s", err, operation)
+	}
+
+	cmd.Printf("Safety snapshot %s completed\n", snapshotId)
+	return nil
+}
@@ -0,0 +1,147 @@
+// Copyright (c) "Neo4j"
+// Neo4j Sweden AB [http://neo4j.com]
+
+package snapshot_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/test/testutils"
+)
+
+const restoreResponse = `{
+	"data": {
+		"id": "2f49c2b3",
+		"name": "Production",
+		"status": "restoring"
+	}
+}`
+
+func TestRestoreSnapshot(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	restoreMock := helper.NewRequestHandlerMock("POST /v1/instances/2f49c2b3/snapshots/snap123/restore", http.StatusAccepted, restoreResponse)
+
+	helper.ExecuteCommand("instance snapshot restore --instance-id 2f49c2b3 --snapshot-id snap123 --yes")
+
+	restoreMock.AssertCalledTimes(1)
+	helper.AssertErr("")
+	helper.AssertOutJson(restoreResponse)
+}
+
+func TestRestoreSnapshotWithConfirmation(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	restoreMock := helper.NewRequestHandlerMock("POST /v1/instances/2f49c2b3/snapshots/snap123/restore", http.StatusAccepted, restoreResponse)
+
+	helper.SetTerminalInput("y\n")
+	helper.ExecuteCommand("instance snapshot restore --instance-id 2f49c2b3 --snapshot-id snap123 --output table")
+
+	restoreMock.AssertCalledTimes(1)
+	helper.AssertErr("")
+	helper.AssertOut(`Restore instance 2f49c2b3 to snapshot snap123? The current data of the instance will be replaced [y/N]: ┌──────────┬────────────┬───────────┬───────────┬────────────────┬────────────────┬────────┬──────┬────────┐
+│ ID       │ NAME       │ TENANT_ID │ STATUS    │ CONNECTION_URL │ CLOUD_PROVIDER │ REGION │ TYPE │ MEMORY │
+├──────────┼────────────┼───────────┼───────────┼────────────────┼────────────────┼────────┼──────┼────────┤
+│ 2f49c2b3 │ Production │           │ restoring │                │                │        │      │        │
+└──────────┴────────────┴───────────┴───────────┴────────────────┴────────────────┴────────┴──────┴────────┘`)
+}
+
+func TestRestoreSnapshotNotConfirmed(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	restoreMock := helper.NewRequestHandlerMock("POST /v1/instances/2f49c2b3/snapshots/snap123/restore", http.StatusAccepted, restoreResponse)
+
+	helper.SetTerminalInput("n\n")
+	helper.ExecuteCommand("instance snapshot restore --instance-id 2f49c2b3 --snapshot-id snap123")
+
+	restoreMock.AssertCalledTimes(0)
+	helper.AssertErr("Error: the operation was not confirmed")
+}
+
+func TestRestoreSnapshotWithoutTerminalDoesNotAsk(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	restoreMock := helper.NewRequestHandlerMock("POST /v1/instances/2f49c2b3/snapshots/snap123/restore", http.StatusAccepted, restoreResponse)
+
+	helper.SetInput("n\n")
+	helper.ExecuteCommand("instance snapshot restore --instance-id 2f49c2b3 --snapshot-id snap123")
+
+	restoreMock.AssertCalledTimes(1)
+	helper.AssertErr("")
+}
+
+func TestRestoreSnapshotOfProtectedInstance(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.SetConfigValue("aura.protected-resources", []string{"^Prod"})
+	helper.NewRequestHandlerMock("GET /v1/instances/2f49c2b3", http.StatusOK, restoreResponse)
+	restoreMock := helper.NewRequestHandlerMock("POST /v1/instances/2f49c2b3/snapshots/snap123/restore", http.StatusAccepted, restoreResponse)
+
+	helper.ExecuteCommand("instance snapshot restore --instance-id 2f49c2b3 --snapshot-id snap123 --yes")
+
+	restoreMock.AssertCalledTimes(0)
+	helper.AssertErr("Error: instance 2f49c2b3 is protected by the protected-resources config value, use --force to restore it")
+}
+
+func TestRestoreSnapshotOfProtectedInstanceWithForce(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.SetConfigValue("aura.protected-resources", []string{"2f49c2b3"})
+	restoreMock := helper.NewRequestHandlerMock("POST /v1/instances/2f49c2b3/snapshots/snap123/restore", http.StatusAccepted, restoreResponse)
+
+	helper.ExecuteCommand("instance snapshot restore --instance-id 2f49c2b3 --snapshot-id snap123 --yes --force")
+
+	restoreMock.AssertCalledTimes(1)
+	helper.AssertErr("")
+}
+
+func TestRestoreSnapshotWithSafetySnapshotAndAwait(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	createMock := helper.NewRequestHandlerMock("POST /v1/instances/2f49c2b3/snapshots", http.StatusAccepted, `{"data": {"snapshot_id": "safety1"}}`)
+	helper.NewRequestHandlerMock("GET /v1/instances/2f49c2b3/snapshots/safety1", http.StatusOK, `{"data": {"snapshot_id": "safety1", "status": "InProgress"}}`).
+		AddResponse(http.StatusOK, `{"data": {"snapshot_id": "safety1", "status": "Completed"}}`)
+	restoreMock := helper.NewRequestHandlerMock("POST /v1/instances/2f49c2b3/snapshots/snap123/restore", http.StatusAccepted, restoreResponse)
+	getMock := helper.NewRequestHandlerMock("GET /v1/instances/2f49c2b3", http.StatusOK, `{"data": {"id": "2f49c2b3", "status": "restoring"}}`).
+		AddResponse(http.StatusOK, `{"data": {"id": "2f49c2b3", "status": "running"}}`)
+
+	helper.ExecuteCommand("instance snapshot restore --instance-id 2f49c2b3 --snapshot-id snap123 --yes --safety-snapshot --await")
+
+	createMock.AssertCalledTimes(1)
+	restoreMock.AssertCalledTimes(1)
+	getMock.AssertCalledTimes(2)
+	helper.AssertErr("")
+	helper.AssertOut(`Waiting for safety snapshot safety1 to be ready...
+Safety snapshot safety1 completed
+{
+	"data": {
+		"id": "2f49c2b3",
+		"name": "Production",
+		"status": "restoring"
+	}
+}
+Waiting for instance to be restored...
+Instance Status: running`)
+}
+
+func TestRestoreSnapshotWithFailedSafetySnapshot(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.NewRequestHandlerMock("POST /v1/instances/2f49c2b3/snapshots", http.StatusAccepted, `{"data": {"snapshot_id": "safety1"}}`)
+	helper.NewRequestHandlerMock("GET /v1/instances/2f49c2b3/snapshots/safety1", http.StatusOK, `{"data": {"snapshot_id": "safety1", "status": "Failed"}}`)
+	restoreMock := helper.NewRequestHandlerMock("POST /v1/instances/2f49c2b3/snapshots/snap123/restore", http.StatusAccepted, restoreResponse)
+
+	helper.ExecuteCommand("instance snapshot restore --instance-id 2f49c2b3 --snapshot-id snap123 --yes --safety-snapshot")
+
+	restoreMock.AssertCalledTimes(0)
+	helper.AssertErr("Error: safety snapshot safety1 of instance 2f49c2b3 did not complete, its status is Failed, the instance was not restored")
+}
@@ -17,6 +17,8 @@ func NewCmd(cfg *clicfg.Config) *cobra.Command {
 	cmd.AddCommand(NewListCmd(cfg))
 	cmd.AddCommand(NewCreateCmd(cfg))
 	cmd.AddCommand(NewGetCmd(cfg))
+	cmd.AddCommand(NewRestoreCmd(cfg))
+	cmd.AddCommand(NewReportCmd(cfg))
 
 	return cmd
 }
@@ -0,0 +1,70 @@
+// Copyright (c) "Neo4j"
+// Neo4j Sweden AB [http://neo4j.com]
+
+package instance
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/neo4j/cli/common/clicfg"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/api"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/subcommands/instance/snapshot"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/subcommands/utils"
+	"github.com/spf13/cobra"
+)
+
+const snapshotFirstFlag = "snapshot-first"
+
+const snapshotFirstHelp = `
+
+With --snapshot-first a snapshot of the instance is taken first, and the operation only proceeds once the snapshot is completed. The snapshot is taken by default for the production instances set with the production-instances config value, which lists instance IDs and regular expressions matching instance names, unless --snapshot-first=false is set.`
+
+func addSnapshotFirstFlag(cmd *cobra.Command, snapshotFirst *bool) {
+	cmd.Flags().BoolVar(snapshotFirst, snapshotFirstFlag, false, "Takes a snapshot of the instance and waits for it to complete first, defaults to true for production instances")
+}
+
+// Returns whether the instance has to be snapshotted first, which is the value of --snapshot-first when set, and whether it is a production instance otherwise
+func snapshotFirstEnabled(cmd *cobra.Command, cfg *clicfg.Config, snapshotFirst bool, instance map[string]any) bool {
+	if cmd.Flags().Changed(snapshotFirstFlag) {
+		return snapshotFirst
+	}
+	return isProductionInstance(cfg, instance)
+}
+
+// Returns whether the ID of the instance or its name matches one of the production-instances config value
+func isProductionInstance(cfg *clicfg.Config, instance map[string]any) bool {
+	name, _ := instance["name"].(string)
+	return utils.MatchesAny(cfg.Aura.ProductionInstances(), fmt.Sprint(instance["id"]), name)
+}
+
+// Returns the instance when it is needed to tell whether it is a production instance, and only its ID otherwise
+func getInstanceForSnapshotFirst(cmd *cobra.Command, cfg *clicfg.Config, instanceId string) (map[string]any, error) {
+	instance := map[string]any{"id": instanceId}
+	if cmd.Flags().Changed(snapshotFirstFlag) || len(cfg.Aura.ProductionInstances()) == 0 {
+		return instance, nil
+	}
+
+	resBody, _, err := api.MakeRequest(cfg, fmt.Sprintf("/instances/%s", instanceId), &api.RequestConfig{
+		Method: http.MethodGet,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return api.ParseBody(resBody).GetSingleOrError()
+}
+
+// Takes a safety snapshot of the instance when it has to be snapshotted first, the operation is named in the error, e.g. deleted
+func runSnapshotFirst(cmd *cobra.Command, cfg *clicfg.Config, snapshotFirst bool, instanceId string, operation string) error {
+	instance, err := getInstanceForSnapshotFirst(cmd, cfg, instanceId)
+	if err != nil {
+		return err
+	}
+	if !snapshotFirstEnabled(cmd, cfg, snapshotFirst, instance) {
+		return nil
+	}
+	if !cmd.Flags().Changed(snapshotFirstFlag) {
+		cmd.Printf("Instance %s is a production instance, taking a snapshot first\n", instanceId)
+	}
+	return snapshot.TakeSafetySnapshot(cmd, cfg, instanceId, operation)
+}
@@ -0,0 +1,143 @@
+// Copyright (c) "Neo4j"
+// Neo4j Sweden AB [http://neo4j.com]
+
+package instance_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/test/testutils"
+)
+
+const deletedInstance = `{"data": {"id": "2f49c2b3", "name": "prod-orders", "status": "destroying"}}`
+
+func TestDeleteInstanceWithSnapshotFirst(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	createMock := helper.NewRequestHandlerMock("POST /v1/instances/2f49c2b3/snapshots", http.StatusAccepted, `{"data": {"snapshot_id": "safety1"}}`)
+	helper.NewRequestHandlerMock("GET /v1/instances/2f49c2b3/snapshots/safety1", http.StatusOK, `{"data": {"snapshot_id": "safety1", "status": "InProgress"}}`).
+		AddResponse(http.StatusOK, `{"data": {"snapshot_id": "safety1", "status": "Completed"}}`)
+	deleteMock := helper.NewRequestHandlerMock("DELETE /v1/instances/2f49c2b3", http.StatusAccepted, deletedInstance)
+
+	helper.ExecuteCommand("instance delete 2f49c2b3 --snapshot-first")
+
+	createMock.AssertCalledTimes(1)
+	deleteMock.AssertCalledTimes(1)
+	helper.AssertErr("")
+	helper.AssertOut(`Waiting for safety snapshot safety1 to be ready...
+Safety snapshot safety1 completed
+{
+	"data": {
+		"id": "2f49c2b3",
+		"name": "prod-orders",
+		"status": "destroying"
+	}
+}`)
+}
+
+func TestDeleteInstanceWithFailedSnapshotFirst(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.NewRequestHandlerMock("POST /v1/instances/2f49c2b3/snapshots", http.StatusAccepted, `{"data": {"snapshot_id": "safety1"}}`)
+	helper.NewRequestHandlerMock("GET /v1/instances/2f49c2b3/snapshots/safety1", http.StatusOK, `{"data": {"snapshot_id": "safety1", "status": "Failed"}}`)
+	deleteMock := helper.NewRequestHandlerMock("DELETE /v1/instances/2f49c2b3", http.StatusAccepted, deletedInstance)
+
+	helper.ExecuteCommand("instance delete 2f49c2b3 --snapshot-first")
+
+	deleteMock.AssertCalledTimes(0)
+	helper.AssertErr("Error: safety snapshot safety1 of instance 2f49c2b3 did not complete, its status is Failed, the instance was not deleted")
+}
+
+func TestDeleteProductionInstanceTakesSnapshotFirst(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.SetConfigValue("aura.production-instances", []string{"^prod-"})
+
+	helper.NewRequestHandlerMock("GET /v1/instances/2f49c2b3", http.StatusOK, `{"data": {"id": "2f49c2b3", "name": "prod-orders", "status": "running"}}`)
+	createMock := helper.NewRequestHandlerMock("POST /v1/instances/2f49c2b3/snapshots", http.StatusAccepted, `{"data": {"snapshot_id": "safety1"}}`)
+	helper.NewRequestHandlerMock("GET /v1/instances/2f49c2b3/snapshots/safety1", http.StatusOK, `{"data": {"snapshot_id": "safety1", "status": "Completed"}}`)
+	deleteMock := helper.NewRequestHandlerMock("DELETE /v1/instances/2f49c2b3", http.StatusAccepted, deletedInstance)
+
+	helper.ExecuteCommand("instance delete 2f49c2b3 --output table")
+
+	createMock.AssertCalledTimes(1)
+	deleteMock.AssertCalledTimes(1)
+	helper.AssertErr("")
+	helper.AssertOut(`Instance 2f49c2b3 is a production instance, taking a snapshot first
+Waiting for safety snapshot safety1 to be ready...
+Safety snapshot safety1 completed
+┌──────────┬─────────────┬───────────┬────────────┬────────────────┬────────────────┬────────┬──────┬────────┐
+│ ID       │ NAME        │ TENANT_ID │ STATUS     │ CONNECTION_URL │ CLOUD_PROVIDER │ REGION │ TYPE │ MEMORY │
+├──────────┼─────────────┼───────────┼────────────┼────────────────┼────────────────┼────────┼──────┼────────┤
+│ 2f49c2b3 │ prod-orders │           │ destroying │                │                │        │      │        │
+└──────────┴─────────────┴───────────┴────────────┴────────────────┴────────────────┴────────┴──────┴────────┘`)
+}
+
+func TestDeleteProductionInstanceWithoutSnapshotFirst(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.SetConfigValue("aura.production-instances", []string{"2f49c2b3"})
+
+	createMock := helper.NewRequestHandlerMock("POST /v1/instances/2f49c2b3/snapshots", http.StatusAccepted, `{"data": {"snapshot_id": "safety1"}}`)
+	deleteMock := helper.NewRequestHandlerMock("DELETE /v1/instances/2f49c2b3", http.StatusAccepted, deletedInstance)
+
+	helper.ExecuteCommand("instance delete 2f49c2b3 --snapshot-first=false")
+
+	createMock.AssertCalledTimes(0)
+	deleteMock.AssertCalledTimes(1)
+	helper.AssertErr("")
+}
+
+func TestOverwriteProductionInstanceTakesSnapshotFirst(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.SetConfigValue("aura.production-instances", []string{"2f49c2b3"})
+
+	helper.NewRequestHandlerMock("GET /v1/instances/2f49c2b3", http.StatusOK, `{"data": {"id": "2f49c2b3", "name": "orders", "status": "running"}}`)
+	createMock := helper.NewRequestHandlerMock("POST /v1/instances/2f49c2b3/snapshots", http.StatusAccepted, `{"data": {"snapshot_id": "safety1"}}`)
+	helper.NewRequestHandlerMock("GET /v1/instances/2f49c2b3/snapshots/safety1", http.StatusOK, `{"data": {"snapshot_id": "safety1", "status": "Completed"}}`)
+	overwriteMock := helper.NewRequestHandlerMock("POST /v1/instances/2f49c2b3/overwrite", http.StatusAccepted, `{"data": {"id": "2f49c2b3", "status": "overwriting"}}`)
+
+	helper.ExecuteCommand("instance overwrite 2f49c2b3 --source-instance-id 191b0da2")
+
+	createMock.AssertCalledTimes(1)
+	overwriteMock.AssertCalledTimes(1)
+	helper.AssertErr("")
+}
+
+func TestDeleteInstancesWithSnapshotFirst(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.SetConfigValue("aura.production-instances", []string{"^prod-"})
+
+	helper.NewRequestHandlerMock("GET /v1/instances", http.StatusOK, `{"data": [
+		{"id": "2f49c2b3", "name": "prod-orders", "tenant_id": "YOUR_TENANT_ID"},
+		{"id": "191b0da2", "name": "dev-orders", "tenant_id": "YOUR_TENANT_ID"}
+	]}`)
+	helper.NewRequestHandlerMock("GET /v1/instances/2f49c2b3", http.StatusOK, `{"data": {"id": "2f49c2b3", "name": "prod-orders", "tenant_id": "YOUR_TENANT_ID", "status": "running"}}`)
+	helper.NewRequestHandlerMock("GET /v1/instances/191b0da2", http.StatusOK, `{"data": {"id": "191b0da2", "name": "dev-orders", "tenant_id": "YOUR_TENANT_ID", "status": "running"}}`)
+	prodSnapshotMock := helper.NewRequestHandlerMock("POST /v1/instances/2f49c2b3/snapshots", http.StatusAccepted, `{"data": {"snapshot_id": "safety1"}}`)
+	helper.NewRequestHandlerMock("GET /v1/instances/2f49c2b3/snapshots/safety1", http.StatusOK, `{"data": {"snapshot_id": "safety1", "status": "Completed"}}`)
+	devSnapshotMock := helper.NewRequestHandlerMock("POST /v1/instances/191b0da2/snapshots", http.StatusAccepted, `{"data": {"snapshot_id": "safety2"}}`)
+	helper.NewRequestHandlerMock("DELETE /v1/instances/2f49c2b3", http.StatusAccepted, `{"data": {"id": "2f49c2b3", "status": "destroying"}}`)
+	helper.NewRequestHandlerMock("DELETE /v1/instances/191b0da2", http.StatusAccepted, `{"data": {"id": "191b0da2", "status": "destroying"}}`)
+
+	helper.ExecuteCommand("instance delete --all")
+
+	prodSnapshotMock.AssertCalledTimes(1)
+	devSnapshotMock.AssertCalledTimes(0)
+	helper.AssertErr("")
+	helper.AssertOutJson(`{
+		"data": [
+			{"id": "2f49c2b3", "message": "safety snapshot safety1 completed", "name": "prod-orders", "result": "succeeded", "status": "destroying", "tenant_id": "YOUR_TENANT_ID"},
+			{"id": "191b0da2", "message": "", "name": "dev-orders", "result": "succeeded", "status": "destroying", "tenant_id": "YOUR_TENANT_ID"}
+		]
+	}`)
+}
@@ -8,28 +8,45 @@ import (
 	"net/http"
 
 	"github.com/neo4j/cli/common/clicfg"
+	"github.com/neo4j/cli/common/clierr"
 	"github.com/neo4j/cli/neo4j-cli/aura/internal/api"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/flags"
 	"github.com/neo4j/cli/neo4j-cli/aura/internal/output"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/subcommands/utils"
 	"github.com/spf13/cobra"
 )
 
 func NewUpdateCmd(cfg *clicfg.Config) *cobra.Command {
 	var (
-		memory string
-		name   string
+		memory               string
+		name                 string
+		storage              string
+		secondariesCount     int
+		cdcEnrichmentMode    flags.CdcEnrichmentMode
+		vectorOptimized      bool
+		graphAnalyticsPlugin bool
+		await                bool
 	)
 
 	const (
-		memoryFlag = "memory"
-		nameFlag   = "name"
+		memoryFlag               = "memory"
+		nameFlag                 = "name"
+		storageFlag              = "storage"
+		secondariesCountFlag     = "secondaries-count"
+		cdcEnrichmentModeFlag    = "cdc-enrichment-mode"
+		vectorOptimizedFlag      = "vector-optimized"
+		graphAnalyticsPluginFlag = "graph-analytics-plugin"
+		awaitFlag                = "await"
 	)
 
 	cmd := &cobra.Command{
 		Use:   "update <id>",
 		Short: "Updates an instance",
-		Long: `This command allows you to rename and/or resize an Aura instance.
+		Long: `This command allows you to rename an Aura instance, resize its memory and storage and change its settings.
 
-Resizing an instance is an asynchronous operation. The instance remains available throughout.`,
+The number of secondaries can only be set for business-critical instances and the graph analytics plugin only for professional-db instances. The CDC enrichment mode is one of OFF, DIFF or FULL. The vector optimization and the graph analytics plugin are enabled with --vector-optimized and --graph-analytics-plugin and disabled with --vector-optimized=false and --graph-analytics-plugin=false.
+
+Updating an instance is an asynchronous operation that can be awaited with --await, which polls the instance until its status is no longer "updating" once the update has started. The instance remains available throughout.`,
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			body := map[string]any{}
@@ -42,9 +59,51 @@ Resizing an instance is an asynchronous operation. The instance remains availabl
 				body["name"] = name
 			}
 
-			path := fmt.Sprintf("/instances/%s", args[0])
+			if storage != "" {
+				body["storage"] = storage
+			}
+
+			if cmd.Flags().Changed(secondariesCountFlag) {
+				if secondariesCount < 0 {
+					return clierr.NewUsageError(`invalid argument "%d" for "--%s" flag: must not be negative`, secondariesCount, secondariesCountFlag)
+				}
+				body["secondaries_count"] = secondariesCount
+			}
+
+			if cdcEnrichmentMode != "" {
+				body["cdc_enrichment_mode"] = cdcEnrichmentMode
+			}
+
+			if cmd.Flags().Changed(vectorOptimizedFlag) {
+				body["vector_optimized"] = vectorOptimized
+			}
+
+			if cmd.Flags().Changed(graphAnalyticsPluginFlag) {
+				body["graph_analytics_plugin"] = graphAnalyticsPlugin
+			}
 
 			cmd.SilenceUsage = true
+			instanceId, err := utils.ResolveInstanceId(cfg, args[0])
+			if err != nil {
+				return err
+			}
+
+			path := fmt.Sprintf("/instances/%s", instanceId)
+
+			// Settings that only some types of instances have are checked against the type of the instance first
+			typedFlags := []typedFlag{}
+			if _, ok := body["secondaries_count"]; ok {
+				typedFlags = append(typedFlags, typedFlag{flag: secondariesCountFlag, instanceType: "business-critical"})
+			}
+			if _, ok := body["graph_analytics_plugin"]; ok {
+				typedFlags = append(typedFlags, typedFlag{flag: graphAnalyticsPluginFlag, instanceType: "professional-db"})
+			}
+			if len(typedFlags) > 0 {
+				if err := checkInstanceType(cfg, instanceId, typedFlags); err != nil {
+					return err
+				}
+			}
+
 			resBody, statusCode, err := api.MakeRequest(cfg, path, &api.RequestConfig{
 				Method:   http.MethodPatch,
 				PostBody: body,
@@ -54,7 +113,17 @@ Resizing an instance is an asynchronous operation. The instance remains availabl
 			}
 
 			if statusCode == http.StatusAccepted || statusCode == http.StatusOK {
-				output.PrintBody(cmd, cfg, resBody, []string{"id", "name", "tenant_id", "status", "connection_url", "cloud_provider", "region", "type", "memory"})
+				output.PrintBody(cmd, cfg, resBody, []string{"id", "name", "tenant_id", "status", "connection_url", "cloud_provider", "region", "type", "memory", "storage"})
+
+				if await {
+					cmd.Println("Waiting for instance to be updated...")
+					pollResponse, err := api.PollInstanceUpdated(cfg, instanceId, body)
+					if err != nil {
+						return err
+					}
+
+					cmd.Println("Instance Status:", pollResponse.Data.Status)
+				}
 			}
 			return nil
 		},
@@ -64,7 +133,45 @@ Resizing an instance is an asynchronous operation. The instance remains availabl
 
 	cmd.Flags().StringVar(&name, nameFlag, "", "The name of the instance (any UTF-8 characters with no trailing or leading whitespace).")
 
-	cmd.MarkFlagsOneRequired(memoryFlag, nameFlag)
+	cmd.Flags().StringVar(&storage, storageFlag, "", "The size of the instance storage in GB.")
+
+	cmd.Flags().IntVar(&secondariesCount, secondariesCountFlag, 0, "The number of secondaries of a business-critical instance.")
+
+	cmd.Flags().Var(&cdcEnrichmentMode, cdcEnrichmentModeFlag, "The CDC enrichment mode of the instance, one of OFF, DIFF or FULL.")
+
+	cmd.Flags().BoolVar(&vectorOptimized, vectorOptimizedFlag, false, "Enables or, with --vector-optimized=false, disables the vector optimization of the instance.")
+
+	cmd.Flags().BoolVar(&graphAnalyticsPlugin, graphAnalyticsPluginFlag, false, "Enables or, with --graph-analytics-plugin=false, disables the graph analytics plugin of a professional-db instance.")
+
+	cmd.Flags().BoolVar(&await, awaitFlag, false, "Waits until the instance is no longer updating.")
+
+	cmd.MarkFlagsOneRequired(memoryFlag, nameFlag, storageFlag, secondariesCountFlag, cdcEnrichmentModeFlag, vectorOptimizedFlag, graphAnalyticsPluginFlag)
 
 	return cmd
 }
+
+// A flag that can only be set for one type of instance
+type typedFlag struct {
+	flag         string
+	instanceType string
+}
+
+// Returns a usage error when the instance is not of the type one of the flags can only be set for
+func checkInstanceType(cfg *clicfg.Config, instanceId string, flags []typedFlag) error {
+	resBody, _, err := api.MakeRequest(cfg, fmt.Sprintf("/instances/%s", instanceId), &api.RequestConfig{
+		Method: http.MethodGet,
+	})
+	if err != nil {
+		return err
+	}
+	instance, err := api.ParseBody(resBody).GetSingleOrError()
+	if err != nil {
+		return err
+	}
+	for _, flag := range flags {
+		if instance["type"] != flag.instanceType {
+			return clierr.NewUsageError(`"--%s" flag can only be set for %s instances, instance %s is of type %s`, flag.flag, flag.instanceType, instanceId, instance["type"])
+		}
+	}
+	return nil
+}
@@ -9,6 +9,7 @@ import (
 	"testing"
 
 	"github.com/neo4j/cli/neo4j-cli/aura/internal/test/testutils"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestUpdateMemory(t *testing.T) {
@@ -146,7 +147,7 @@ func TestUpdateErrorsWithNoFlags(t *testing.T) {
 
 	mockHandler.AssertCalledTimes(0)
 
-	helper.AssertErr(`Error: at least one of the flags in the group [memory name] is required
+	helper.AssertErr(`Error: at least one of the flags in the group [memory name storage secondaries-count cdc-enrichment-mode vector-optimized graph-analytics-plugin] is required
 `)
 }
 
@@ -201,3 +202,93 @@ func TestUpdateInstanceError(t *testing.T) {
 		})
 	}
 }
+
+func TestUpdateStorageAndSettings(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	mockHandler := helper.NewRequestHandlerMock("PATCH /v1/instances/2f49c2b3", http.StatusAccepted, `{
+		"data": {"id": "2f49c2b3", "name": "Production", "status": "updating", "tenant_id": "YOUR_TENANT_ID", "memory": "8GB", "storage": "32GB", "type": "enterprise-db"}
+	}`)
+
+	helper.ExecuteCommand("instance update 2f49c2b3 --storage 32GB --cdc-enrichment-mode DIFF --vector-optimized=false")
+
+	mockHandler.AssertCalledTimes(1)
+	mockHandler.AssertCalledWithBody(`{"cdc_enrichment_mode":"DIFF","storage":"32GB","vector_optimized":false}`)
+	helper.AssertErr("")
+}
+
+func TestUpdateSecondariesCountAndAwait(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	getMock := helper.NewRequestHandlerMock("GET /v1/instances/2f49c2b3", http.StatusOK, `{"data": {"id": "2f49c2b3", "type": "business-critical", "status": "running"}}`).
+		AddResponse(http.StatusOK, `{"data": {"id": "2f49c2b3", "type": "business-critical", "status": "updating"}}`).
+		AddResponse(http.StatusOK, `{"data": {"id": "2f49c2b3", "type": "business-critical", "status": "running"}}`)
+	patchMock := helper.NewRequestHandlerMock("PATCH /v1/instances/2f49c2b3", http.StatusAccepted, `{
+		"data": {"id": "2f49c2b3", "name": "Production", "status": "updating", "tenant_id": "YOUR_TENANT_ID", "memory": "8GB", "storage": "16GB", "type": "business-critical"}
+	}`)
+
+	helper.ExecuteCommand("instance update 2f49c2b3 --secondaries-count 2 --await --output table")
+
+	getMock.AssertCalledTimes(3)
+	patchMock.AssertCalledTimes(1)
+	patchMock.AssertCalledWithBody(`{"secondaries_count":2}`)
+	helper.AssertErr("")
+	assert.Contains(t, helper.PrintOut(), "Waiting for instance to be updated...\nInstance Status: running")
+}
+
+func TestUpdateMemoryAndAwaitBeforeUpdateStarts(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	// The instance is still running with its old memory when first polled
+	getMock := helper.NewRequestHandlerMock("GET /v1/instances/2f49c2b3", http.StatusOK, `{"data": {"id": "2f49c2b3", "status": "running", "memory": "4GB"}}`).
+		AddResponse(http.StatusOK, `{"data": {"id": "2f49c2b3", "status": "running", "memory": "4GB"}}`).
+		AddResponse(http.StatusOK, `{"data": {"id": "2f49c2b3", "status": "running", "memory": "8GB"}}`)
+	helper.NewRequestHandlerMock("PATCH /v1/instances/2f49c2b3", http.StatusAccepted, `{
+		"data": {"id": "2f49c2b3", "name": "Production", "status": "running", "tenant_id": "YOUR_TENANT_ID", "memory": "4GB"}
+	}`)
+
+	helper.ExecuteCommand("instance update 2f49c2b3 --memory 8GB --await")
+
+	getMock.AssertCalledTimes(3)
+	helper.AssertErr("")
+	assert.Contains(t, helper.PrintOut(), "Waiting for instance to be updated...\nInstance Status: running")
+}
+
+func TestUpdateSecondariesCountAndGraphAnalyticsPluginGetsInstanceOnce(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	getMock := helper.NewRequestHandlerMock("GET /v1/instances/2f49c2b3", http.StatusOK, `{"data": {"id": "2f49c2b3", "type": "business-critical", "status": "running"}}`)
+	patchMock := helper.NewRequestHandlerMock("PATCH /v1/instances/2f49c2b3", http.StatusAccepted, `{"data": {}}`)
+
+	helper.ExecuteCommand("instance update 2f49c2b3 --secondaries-count 2 --graph-analytics-plugin")
+
+	getMock.AssertCalledTimes(1)
+	patchMock.AssertCalledTimes(0)
+	helper.AssertErr(`Error: "--graph-analytics-plugin" flag can only be set for professional-db instances, instance 2f49c2b3 is of type business-critical`)
+}
+
+func TestUpdateGraphAnalyticsPluginOfWrongType(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.NewRequestHandlerMock("GET /v1/instances/2f49c2b3", http.StatusOK, `{"data": {"id": "2f49c2b3", "type": "enterprise-db", "status": "running"}}`)
+	patchMock := helper.NewRequestHandlerMock("PATCH /v1/instances/2f49c2b3", http.StatusAccepted, `{"data": {}}`)
+
+	helper.ExecuteCommand("instance update 2f49c2b3 --graph-analytics-plugin")
+
+	patchMock.AssertCalledTimes(0)
+	helper.AssertErr(`Error: "--graph-analytics-plugin" flag can only be set for professional-db instances, instance 2f49c2b3 is of type enterprise-db`)
+}
+
+func TestUpdateInvalidCdcEnrichmentMode(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.ExecuteCommand("instance update 2f49c2b3 --cdc-enrichment-mode PARTIAL")
+
+	assert.Contains(t, helper.PrintErr(), `Error: invalid argument "PARTIAL" for "--cdc-enrichment-mode" flag: must be one of "OFF", "DIFF", or "FULL"`)
+}
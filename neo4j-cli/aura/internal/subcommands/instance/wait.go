@@ -0,0 +1,101 @@
+// Copyright (c) "Neo4j"
+// Neo4j Sweden AB [http://neo4j.com]
+
+package instance
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/neo4j/cli/common/clicfg"
+	"github.com/neo4j/cli/common/clierr"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/api"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/output"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/subcommands/utils"
+	"github.com/spf13/cobra"
+)
+
+const (
+	// Status reported for instances that no longer exist
+	statusDeleted = "deleted"
+	// The number of instances polled at the same time
+	waitConcurrency = 4
+)
+
+func NewWaitCmd(cfg *clicfg.Config) *cobra.Command {
+	var (
+		condition string
+	)
+
+	const (
+		forFlag = "for"
+	)
+
+	cmd := &cobra.Command{
+		Use:   "wait <id>...",
+		Short: "Waits for instances to reach a status",
+		Long: `This subcommand waits until each of the given instances reaches the status given with --for, for example after an operation started from the Aura Console or by another job.
+
+The condition is either status=<status>, e.g. status=running or status=paused, or deleted, which waits until the instance no longer exists. The instances are polled concurrently, up to 4 at a time, and the final status of each of them is printed once all of them are done. The command exits with a non-zero status when any instance did not reach the condition in time.`,
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			wantedStatus, ok := strings.CutPrefix(condition, "status=")
+			if condition == statusDeleted {
+				wantedStatus = statusDeleted
+			} else if !ok || wantedStatus == "" {
+				return clierr.NewUsageError(`invalid argument "%s" for "--for" flag: must be of the form status=<status> or deleted`, condition)
+			}
+
+			cmd.SilenceUsage = true
+			instanceIds := make([]string, len(args))
+			for i, arg := range args {
+				instanceId, err := utils.ResolveInstanceId(cfg, arg)
+				if err != nil {
+					return err
+				}
+				instanceIds[i] = instanceId
+			}
+
+			rows := make([]map[string]any, len(instanceIds))
+			utils.RunConcurrently(waitConcurrency, len(instanceIds), func(i int) {
+				row := map[string]any{"id": instanceIds[i], "status": "", "message": ""}
+				rows[i] = row
+
+				if wantedStatus == statusDeleted {
+					if err := api.PollInstanceDeleted(cfg, instanceIds[i]); err != nil {
+						row["message"] = err.Error()
+						return
+					}
+					row["status"] = statusDeleted
+					return
+				}
+
+				pollResponse, err := api.PollNow(cfg, fmt.Sprintf("/instances/%s", instanceIds[i]), func(status string) bool {
+					return status == wantedStatus
+				})
+				if err != nil {
+					row["message"] = err.Error()
+					return
+				}
+				row["status"] = pollResponse.Data.Status
+			})
+
+			output.PrintBodyMap(cmd, cfg, api.NewListResponseData(rows), []string{"id", "status", "message"})
+
+			failed := 0
+			for _, row := range rows {
+				if row["message"] != "" {
+					failed++
+				}
+			}
+			if failed > 0 {
+				return clierr.NewUpstreamError("%d of %d instances did not reach %s", failed, len(rows), condition)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&condition, forFlag, "status=running", "The condition to wait for, status=<status> or deleted")
+
+	return cmd
+}
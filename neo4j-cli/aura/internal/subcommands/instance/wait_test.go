@@ -0,0 +1,99 @@
+// Copyright (c) "Neo4j"
+// Neo4j Sweden AB [http://neo4j.com]
+
+package instance_test
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/test/testutils"
+)
+
+func TestWaitForInstancesStatus(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	firstMock := helper.NewRequestHandlerMock("GET /v1/instances/2f49c2b3", http.StatusOK, `{"data": {"id": "2f49c2b3", "status": "resuming"}}`).
+		AddResponse(http.StatusOK, `{"data": {"id": "2f49c2b3", "status": "running"}}`)
+	secondMock := helper.NewRequestHandlerMock("GET /v1/instances/b51f3a2c", http.StatusOK, `{"data": {"id": "b51f3a2c", "status": "running"}}`)
+
+	helper.ExecuteCommand("instance wait 2f49c2b3 b51f3a2c --for status=running")
+
+	firstMock.AssertCalledTimes(2)
+	secondMock.AssertCalledTimes(1)
+	helper.AssertErr("")
+	helper.AssertOutJson(`{
+		"data": [
+			{"id": "2f49c2b3", "message": "", "status": "running"},
+			{"id": "b51f3a2c", "message": "", "status": "running"}
+		]
+	}`)
+}
+
+func TestWaitForMoreInstancesThanPolledAtOnce(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	ids := []string{"a0000001", "a0000002", "a0000003", "a0000004", "a0000005", "a0000006"}
+	assertCalledTimes := []func(int){}
+	for _, id := range ids {
+		mock := helper.NewRequestHandlerMock("GET /v1/instances/"+id, http.StatusOK, `{"data": {"id": "`+id+`", "status": "running"}}`)
+		assertCalledTimes = append(assertCalledTimes, mock.AssertCalledTimes)
+	}
+
+	helper.ExecuteCommand("instance wait " + strings.Join(ids, " "))
+
+	for _, assertCalled := range assertCalledTimes {
+		assertCalled(1)
+	}
+	helper.AssertErr("")
+}
+
+func TestWaitForInstanceDeleted(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	mock := helper.NewRequestHandlerMock("GET /v1/instances/2f49c2b3", http.StatusOK, `{"data": {"id": "2f49c2b3", "status": "destroying"}}`).
+		AddResponse(http.StatusNotFound, `{"errors": [{"message": "DB not found: 2f49c2b3", "reason": "db-not-found"}]}`)
+
+	helper.ExecuteCommand("instance wait 2f49c2b3 --for deleted")
+
+	mock.AssertCalledTimes(2)
+	helper.AssertErr("")
+	helper.AssertOutJson(`{
+		"data": [
+			{"id": "2f49c2b3", "message": "", "status": "deleted"}
+		]
+	}`)
+}
+
+func TestWaitForInstanceStatusTimesOut(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	mock := helper.NewRequestHandlerMock("GET /v1/instances/2f49c2b3", http.StatusOK, `{"data": {"id": "2f49c2b3", "status": "paused"}}`)
+	for range 4 {
+		mock.AddResponse(http.StatusOK, `{"data": {"id": "2f49c2b3", "status": "paused"}}`)
+	}
+
+	helper.ExecuteCommand("instance wait 2f49c2b3")
+
+	mock.AssertCalledTimes(5)
+	helper.AssertErr("Error: 1 of 1 instances did not reach status=running")
+	helper.AssertOutJson(`{
+		"data": [
+			{"id": "2f49c2b3", "message": "hit max retries [5] polling", "status": ""}
+		]
+	}`)
+}
+
+func TestWaitForInvalidCondition(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.ExecuteCommand("instance wait 2f49c2b3 --for running")
+
+	helper.AssertErr(`Error: invalid argument "running" for "--for" flag: must be of the form status=<status> or deleted`)
+}
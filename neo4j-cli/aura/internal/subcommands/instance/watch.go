@@ -0,0 +1,179 @@
+// Copyright (c) "Neo4j"
+// Neo4j Sweden AB [http://neo4j.com]
+
+package instance
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"os/signal"
+	"sort"
+	"syscall"
+	"time"
+
+	"github.com/neo4j/cli/common/clicfg"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/api"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/subcommands/utils"
+	"github.com/spf13/cobra"
+)
+
+// The number of instances fetched at the same time
+const watchConcurrency = 4
+
+type watchedInstance struct {
+	id     string
+	name   string
+	status string
+}
+
+func NewWatchCmd(cfg *clicfg.Config) *cobra.Command {
+	var (
+		interval time.Duration
+	)
+
+	const (
+		intervalFlag = "interval"
+	)
+
+	cmd := &cobra.Command{
+		Use:   "watch [<id>...]",
+		Short: "Prints the status changes of instances",
+		Long: `This subcommand polls the given instances, or all instances when no ID is given, every --interval and prints a line with a timestamp whenever the status of an instance changes, until it is interrupted.
+
+When specific instances are watched, the subcommand also ends once all of them are deleted. With --output json each change is printed as a JSON object on its own line.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			instanceIds := make([]string, len(args))
+			for i, arg := range args {
+				instanceId, err := utils.ResolveInstanceId(cfg, arg)
+				if err != nil {
+					return err
+				}
+				instanceIds[i] = instanceId
+			}
+
+			ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt, syscall.SIGTERM)
+			defer stop()
+
+			known := map[string]*watchedInstance{}
+			for {
+				current, err := watchInstances(cfg, instanceIds)
+				if err != nil {
+					cmd.PrintErrln("Warning:", err)
+				} else {
+					printTransitions(cmd, cfg, known, current)
+					known = current
+				}
+
+				if len(instanceIds) > 0 && err == nil && len(known) == 0 {
+					return nil
+				}
+
+				timer := time.NewTimer(interval)
+				select {
+				case <-ctx.Done():
+					timer.Stop()
+					return nil
+				case <-timer.C:
+				}
+			}
+		},
+	}
+
+	cmd.Flags().DurationVar(&interval, intervalFlag, 10*time.Second, "How often to poll the instances")
+
+	return cmd
+}
+
+// Returns the current status of the given instances, or of all instances when none are given. Instances that do not exist are left out.
+func watchInstances(cfg *clicfg.Config, instanceIds []string) (map[string]*watchedInstance, error) {
+	if len(instanceIds) == 0 {
+		resBody, _, err := api.MakeRequest(cfg, "/instances", &api.RequestConfig{
+			Method: http.MethodGet,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, instance := range api.ParseBody(resBody).AsArray() {
+			instanceIds = append(instanceIds, fmt.Sprint(instance["id"]))
+		}
+	}
+
+	instances := make([]*watchedInstance, len(instanceIds))
+	errs := make([]error, len(instanceIds))
+	utils.RunConcurrently(watchConcurrency, len(instanceIds), func(i int) {
+		resBody, statusCode, err := api.MakeRequest(cfg, fmt.Sprintf("/instances/%s", instanceIds[i]), &api.RequestConfig{
+			Method: http.MethodGet,
+		})
+		if statusCode == http.StatusNotFound {
+			return
+		}
+		if err != nil {
+			errs[i] = err
+			return
+		}
+		instance, err := api.ParseBody(resBody).GetSingleOrError()
+		if err != nil {
+			errs[i] = err
+			return
+		}
+		instances[i] = &watchedInstance{
+			id:     instanceIds[i],
+			name:   fmt.Sprint(instance["name"]),
+			status: fmt.Sprint(instance["status"]),
+		}
+	})
+
+	current := map[string]*watchedInstance{}
+	for i, instance := range instances {
+		if errs[i] != nil {
+			return nil, errs[i]
+		}
+		if instance != nil {
+			current[instance.id] = instance
+		}
+	}
+	return current, nil
+}
+
+// Prints a line for every instance that appeared, changed status or disappeared between the previous and the current poll
+func printTransitions(cmd *cobra.Command, cfg *clicfg.Config, previous map[string]*watchedInstance, current map[string]*watchedInstance) {
+	now := time.Now().UTC().Format(time.RFC3339)
+
+	ids := []string{}
+	for id := range previous {
+		ids = append(ids, id)
+	}
+	for id := range current {
+		if _, ok := previous[id]; !ok {
+			ids = append(ids, id)
+		}
+	}
+	sort.Strings(ids)
+
+	for _, id := range ids {
+		before, after := previous[id], current[id]
+		var name, from, to, line string
+		switch {
+		case before == nil:
+			name, to = after.name, after.status
+			line = fmt.Sprintf("%s instance %s (%s) is %s", now, id, name, to)
+		case after == nil:
+			name, from, to = before.name, before.status, statusDeleted
+			line = fmt.Sprintf("%s instance %s (%s) was deleted", now, id, name)
+		case before.status != after.status:
+			name, from, to = after.name, before.status, after.status
+			line = fmt.Sprintf("%s instance %s (%s) changed from %s to %s", now, id, name, from, to)
+		default:
+			continue
+		}
+
+		if cfg.Aura.Output() == "json" {
+			data, _ := json.Marshal(map[string]string{"time": now, "id": id, "name": name, "previous_status": from, "status": to})
+			line = string(data)
+		}
+		cmd.Println(line)
+	}
+}
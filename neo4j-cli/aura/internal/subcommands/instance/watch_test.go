@@ -0,0 +1,61 @@
+// Copyright (c) "Neo4j"
+// Neo4j Sweden AB [http://neo4j.com]
+
+package instance_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/test/testutils"
+	"github.com/stretchr/testify/assert"
+)
+
+var timestampPattern = regexp.MustCompile(`^\d{4}-\d{2}-\d{2}T\d{2}:\d{2}:\d{2}Z `)
+
+func TestWatchInstanceUntilDeleted(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.NewRequestHandlerMock("GET /v1/instances/2f49c2b3", http.StatusOK, `{"data": {"id": "2f49c2b3", "name": "Production", "status": "running"}}`).
+		AddResponse(http.StatusOK, `{"data": {"id": "2f49c2b3", "name": "Production", "status": "running"}}`).
+		AddResponse(http.StatusOK, `{"data": {"id": "2f49c2b3", "name": "Production", "status": "destroying"}}`).
+		AddResponse(http.StatusNotFound, `{"errors": [{"message": "DB not found: 2f49c2b3", "reason": "db-not-found"}]}`)
+
+	helper.ExecuteCommand("instance watch 2f49c2b3 --interval 0s --output table")
+
+	helper.AssertErr("")
+	lines := strings.Split(strings.TrimSpace(helper.PrintOut()), "\n")
+	assert.Len(t, lines, 3)
+	for i, expected := range []string{
+		"instance 2f49c2b3 (Production) is running",
+		"instance 2f49c2b3 (Production) changed from running to destroying",
+		"instance 2f49c2b3 (Production) was deleted",
+	} {
+		assert.Regexp(t, timestampPattern, lines[i])
+		assert.Equal(t, expected, timestampPattern.ReplaceAllString(lines[i], ""))
+	}
+}
+
+func TestWatchInstanceAsJson(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.NewRequestHandlerMock("GET /v1/instances/2f49c2b3", http.StatusOK, `{"data": {"id": "2f49c2b3", "name": "Production", "status": "pausing"}}`).
+		AddResponse(http.StatusNotFound, `{"errors": [{"message": "DB not found: 2f49c2b3", "reason": "db-not-found"}]}`)
+
+	helper.ExecuteCommand("instance watch 2f49c2b3 --interval 0s")
+
+	helper.AssertErr("")
+	lines := strings.Split(strings.TrimSpace(helper.PrintOut()), "\n")
+	assert.Len(t, lines, 2)
+
+	var change map[string]string
+	assert.Nil(t, json.Unmarshal([]byte(lines[1]), &change))
+	assert.NotEmpty(t, change["time"])
+	delete(change, "time")
+	assert.Equal(t, map[string]string{"id": "2f49c2b3", "name": "Production", "previous_status": "pausing", "status": "deleted"}, change)
+}
@@ -0,0 +1,233 @@
+// Copyright (c) "Neo4j"
+// Neo4j Sweden AB [http://neo4j.com]
+
+package instance
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/neo4j/cli/common/clicfg"
+	"github.com/neo4j/cli/common/clierr"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/api"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/subcommands/utils"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+// Asks for the flags of instance create that are not set yet, offering the choices of the instance configurations of the tenant, and sets them on the command.
+// Prints the equivalent command line once all flags are set.
+func runCreateWizard(cmd *cobra.Command, cfg *clicfg.Config) error {
+	flags := cmd.Flags()
+	// An estimate needs neither a name nor waiting for the instance
+	estimate, _ := flags.GetBool("estimate")
+
+	if !estimate && !flags.Changed("name") {
+		name, err := utils.Prompt(cmd, "Name of the instance: ")
+		if err != nil {
+			return err
+		}
+		if name == "" {
+			return clierr.NewUsageError("the instance needs a name")
+		}
+		flags.Set("name", name)
+	}
+
+	tenantId, err := chooseTenant(cmd, cfg)
+	if err != nil {
+		return err
+	}
+
+	configurations, _, err := getInstanceConfigurations(cfg, tenantId, false)
+	if err != nil {
+		return err
+	}
+	if len(configurations) == 0 {
+		return clierr.NewUsageError("tenant %s has no instance configurations available", tenantId)
+	}
+
+	steps := []struct {
+		flag   string
+		label  string
+		field  func(c instanceConfiguration) string
+		option func(c instanceConfiguration) string
+	}{
+		{"type", "type", func(c instanceConfiguration) string { return c.Type }, nil},
+		{"cloud-provider", "cloud provider", func(c instanceConfiguration) string { return c.CloudProvider }, nil},
+		{"region", "region", func(c instanceConfiguration) string { return c.Region }, func(c instanceConfiguration) string {
+			if c.RegionName == "" || c.RegionName == c.Region {
+				return c.Region
+			}
+			return fmt.Sprintf("%s, %s", c.Region, c.RegionName)
+		}},
+		{"version", "Neo4j version", func(c instanceConfiguration) string { return c.Version }, nil},
+		{"memory", "memory", func(c instanceConfiguration) string { return c.Memory }, func(c instanceConfiguration) string {
+			if c.Storage == "" {
+				return c.Memory
+			}
+			return fmt.Sprintf("%s, %s storage", c.Memory, c.Storage)
+		}},
+	}
+
+	candidates := configurations
+	for _, step := range steps {
+		values := []string{}
+		options := []string{}
+		for _, c := range candidates {
+			value := step.field(c)
+			if slices.Contains(values, value) {
+				continue
+			}
+			values = append(values, value)
+			if step.option != nil {
+				options = append(options, step.option(c))
+			} else {
+				options = append(options, value)
+			}
+		}
+		if step.flag == "memory" {
+			sortByMemory(values, options)
+		}
+
+		var value string
+		switch {
+		case flags.Changed(step.flag):
+			value = flags.Lookup(step.flag).Value.String()
+		case len(values) == 1:
+			value = values[0]
+			cmd.Printf("Using %s %s, the only one available\n", step.label, options[0])
+		default:
+			// Defaults to the default value of the flag, such as version 5
+			defaultIndex := max(slices.Index(values, flags.Lookup(step.flag).Value.String()), 0)
+			index, err := utils.Choose(cmd, fmt.Sprintf("Select the %s:", step.label), options, defaultIndex)
+			if err != nil {
+				return err
+			}
+			value = values[index]
+		}
+		if err := flags.Set(step.flag, value); err != nil {
+			return clierr.NewUsageError("invalid %s %s: %s", step.label, value, err)
+		}
+
+		matching := []instanceConfiguration{}
+		for _, c := range candidates {
+			if step.field(c) == value {
+				matching = append(matching, c)
+			}
+		}
+		if len(matching) == 0 {
+			// A value set as a flag that the tenant does not support, the validation of instance create reports it
+			break
+		}
+		candidates = matching
+
+		if step.flag == "type" && value == "free-db" {
+			// Free instances have a fixed configuration
+			break
+		}
+	}
+
+	if !estimate && !flags.Changed("await") {
+		await, err := utils.Confirm(cmd, "Wait until the instance is ready?")
+		if err != nil {
+			return err
+		}
+		if await {
+			flags.Set("await", "true")
+		}
+	}
+
+	cmd.Printf("Equivalent command:\n  %s\n", equivalentCommandLine(cmd))
+	return nil
+}
+
+// Returns the tenant set with --tenant-id, the only tenant, or the tenant chosen from the list, defaulting to the default tenant
+func chooseTenant(cmd *cobra.Command, cfg *clicfg.Config) (string, error) {
+	flags := cmd.Flags()
+	if flags.Changed("tenant-id") {
+		return utils.ResolveTenantId(cfg, flags.Lookup("tenant-id").Value.String())
+	}
+
+	resBody, _, err := api.MakeRequest(cfg, "/tenants", &api.RequestConfig{
+		Method: http.MethodGet,
+	})
+	if err != nil {
+		return "", err
+	}
+	tenants := api.ParseBody(resBody).AsArray()
+	if len(tenants) == 0 {
+		return "", clierr.NewUsageError("no tenants are available to the current credential")
+	}
+
+	ids := []string{}
+	options := []string{}
+	defaultIndex := 0
+	for i, tenant := range tenants {
+		id := fmt.Sprint(tenant["id"])
+		ids = append(ids, id)
+		options = append(options, fmt.Sprintf("%s (%s)", tenant["name"], id))
+		if id == cfg.Aura.DefaultTenant() {
+			defaultIndex = i
+		}
+	}
+
+	index := 0
+	if len(tenants) == 1 {
+		cmd.Printf("Using tenant %s, the only one available\n", options[0])
+	} else {
+		index, err = utils.Choose(cmd, "Select the tenant:", options, defaultIndex)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	flags.Set("tenant-id", ids[index])
+	return ids[index], nil
+}
+
+// Sorts the memory values and their options by size
+func sortByMemory(values []string, options []string) {
+	indexes := make([]int, len(values))
+	for i := range indexes {
+		indexes[i] = i
+	}
+	slices.SortStableFunc(indexes, func(a, b int) int {
+		return memoryInGB(values[a]) - memoryInGB(values[b])
+	})
+
+	sortedValues := make([]string, len(values))
+	sortedOptions := make([]string, len(options))
+	for i, index := range indexes {
+		sortedValues[i] = values[index]
+		sortedOptions[i] = options[index]
+	}
+	copy(values, sortedValues)
+	copy(options, sortedOptions)
+}
+
+var plainArgPattern = regexp.MustCompile(`^[A-Za-z0-9_./:@+=-]+$`)
+
+// Returns the command line that has the same effect without --interactive, using the flags set so far
+func equivalentCommandLine(cmd *cobra.Command) string {
+	args := []string{cmd.CommandPath()}
+	cmd.Flags().VisitAll(func(flag *pflag.Flag) {
+		if !flag.Changed || flag.Name == "interactive" {
+			return
+		}
+		if flag.Value.Type() == "bool" {
+			if flag.Value.String() == "true" {
+				args = append(args, "--"+flag.Name)
+			}
+			return
+		}
+		value := flag.Value.String()
+		if !plainArgPattern.MatchString(value) {
+			value = shellQuote(value)
+		}
+		args = append(args, "--"+flag.Name, value)
+	})
+	return strings.Join(args, " ")
+}
@@ -0,0 +1,105 @@
+// Copyright (c) "Neo4j"
+// Neo4j Sweden AB [http://neo4j.com]
+
+package instance_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/test/testutils"
+	"github.com/stretchr/testify/assert"
+)
+
+const wizardTenants = `{
+	"data": [
+		{"id": "YOUR_TENANT_ID", "name": "Production"},
+		{"id": "OTHER_TENANT_ID", "name": "Staging"}
+	]
+}`
+
+func TestCreateInstanceInteractive(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.NewRequestHandlerMock("GET /v1/tenants", http.StatusOK, wizardTenants)
+	tenantMock := helper.NewRequestHandlerMock("GET /v1/tenants/YOUR_TENANT_ID", http.StatusOK, tenantWithConfigurations)
+	createMock := helper.NewRequestHandlerMock("POST /v1/instances", http.StatusAccepted, `{"data": {"id": "db1d1234", "name": "My Instance"}}`)
+
+	// Name, tenant, type and cloud provider are answered, the only version is used, then region 2, the default memory and no await
+	helper.SetInput("My Instance\n1\ngcp\n2\n\nn\n")
+	helper.ExecuteCommand("instance create --interactive --output json")
+
+	tenantMock.AssertCalledTimes(1)
+	createMock.AssertCalledTimes(1)
+	createMock.AssertCalledWithBody(`{"cloud_provider": "gcp", "graph_analytics_plugin": false, "memory": "8GB", "name": "My Instance", "region": "europe-west2", "tenant_id": "YOUR_TENANT_ID", "type": "professional-db", "vector_optimized": false, "version": "5"}`)
+	helper.AssertErr("")
+
+	out := helper.PrintOut()
+	assert.Contains(t, out, "Select the tenant:\n  1) Production (YOUR_TENANT_ID)\n  2) Staging (OTHER_TENANT_ID)\n")
+	assert.Contains(t, out, "Using type professional-db, the only one available\n")
+	assert.Contains(t, out, "Select the region:\n  1) europe-west1, Belgium (europe-west1)\n  2) europe-west2, London (europe-west2)\n")
+	assert.Contains(t, out, "Using memory 8GB, 16GB storage, the only one available\n")
+	assert.Contains(t, out, "Equivalent command:\n  aura-cli instance create --cloud-provider gcp --memory 8GB --name 'My Instance' --output json --region europe-west2 --tenant-id YOUR_TENANT_ID --type professional-db --version 5\n")
+}
+
+func TestCreateInstanceInteractiveEstimate(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.NewRequestHandlerMock("GET /v1/tenants", http.StatusOK, wizardTenants)
+	helper.NewRequestHandlerMock("GET /v1/tenants/YOUR_TENANT_ID", http.StatusOK, tenantWithPricing)
+	createMock := helper.NewRequestHandlerMock("POST /v1/instances", http.StatusAccepted, `{"data": {}}`)
+
+	// Only the tenant, region and memory are asked for
+	helper.SetInput("1\n1\n2\n")
+	helper.ExecuteCommand("instance create --interactive --estimate --output json")
+
+	createMock.AssertCalledTimes(0)
+	helper.AssertErr("")
+
+	out := helper.PrintOut()
+	assert.NotContains(t, out, "Name of the instance:")
+	assert.NotContains(t, out, "Wait until the instance is ready?")
+	assert.Contains(t, out, "aura-cli instance create --cloud-provider gcp --estimate --memory 8GB --output json --region europe-west1 --tenant-id YOUR_TENANT_ID --type professional-db --version 5\n")
+	assert.Contains(t, out, `"hourly": "0.5200"`)
+}
+
+func TestCreateInstanceInteractiveKeepsSetFlags(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.NewRequestHandlerMock("GET /v1/tenants", http.StatusOK, wizardTenants)
+	helper.NewRequestHandlerMock("GET /v1/tenants/YOUR_TENANT_ID", http.StatusOK, tenantWithConfigurations)
+	createMock := helper.NewRequestHandlerMock("POST /v1/instances", http.StatusAccepted, `{"data": {"id": "db1d1234"}}`)
+	helper.NewRequestHandlerMock("GET /v1/instances/db1d1234", http.StatusOK, `{"data": {"id": "db1d1234", "status": "running"}}`)
+
+	// Only the memory and await are asked for
+	helper.SetInput("2\ny\n")
+	helper.ExecuteCommand("instance create --interactive --name Instance01 --tenant-id YOUR_TENANT_ID --cloud-provider gcp --region europe-west1 --output json")
+
+	createMock.AssertCalledTimes(1)
+	createMock.AssertCalledWithBody(`{"cloud_provider": "gcp", "graph_analytics_plugin": false, "memory": "8GB", "name": "Instance01", "region": "europe-west1", "tenant_id": "YOUR_TENANT_ID", "type": "professional-db", "vector_optimized": false, "version": "5"}`)
+	helper.AssertErr("")
+
+	out := helper.PrintOut()
+	assert.NotContains(t, out, "Select the tenant:")
+	assert.Contains(t, out, "Select the memory:\n  1) 2GB, 4GB storage\n  2) 8GB, 16GB storage\n")
+	assert.Contains(t, out, "aura-cli instance create --await --cloud-provider gcp --memory 8GB --name Instance01 --output json --region europe-west1 --tenant-id YOUR_TENANT_ID --type professional-db --version 5\n")
+	assert.Contains(t, out, "Instance Status: running")
+}
+
+func TestCreateInstanceInteractiveWithInvalidChoice(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.NewRequestHandlerMock("GET /v1/tenants", http.StatusOK, wizardTenants)
+	createMock := helper.NewRequestHandlerMock("POST /v1/instances", http.StatusAccepted, `{"data": {}}`)
+
+	helper.SetInput("Instance01\n3\nx\n0\n")
+	helper.ExecuteCommand("instance create --interactive")
+
+	createMock.AssertCalledTimes(0)
+	assert.Contains(t, helper.PrintOut(), "Please enter a number between 1 and 2\n")
+	helper.AssertErr("Error: no valid choice was given for: Select the tenant:")
+}
@@ -0,0 +1,350 @@
+// Copyright (c) "Neo4j"
+// Neo4j Sweden AB [http://neo4j.com]
+
+package inventory
+
+import (
+	"cmp"
+	"fmt"
+	"maps"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/neo4j/cli/common/clicfg"
+	"github.com/neo4j/cli/common/clicfg/credentials"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/api"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/subcommands/utils"
+)
+
+const (
+	kindTenant             = "tenant"
+	kindInstance           = "instance"
+	kindCustomerManagedKey = "customer_managed_key"
+	kindDataApi            = "data_api"
+	kindSession            = "session"
+)
+
+type Report struct {
+	Credentials         []string         `json:"credentials"`
+	Tenants             []map[string]any `json:"tenants"`
+	Instances           []map[string]any `json:"instances"`
+	CustomerManagedKeys []map[string]any `json:"customer_managed_keys"`
+	// Only collected when beta is enabled, like the data-api commands
+	DataApis []map[string]any `json:"data_apis,omitempty"`
+	Sessions []map[string]any `json:"sessions"`
+	Totals   Totals           `json:"totals"`
+	Orphans  []Orphan         `json:"orphans"`
+	// Requests that failed, the report is incomplete when there are any
+	Errors []string `json:"errors"`
+}
+
+type Totals struct {
+	Tenants             int            `json:"tenants"`
+	Instances           int            `json:"instances"`
+	InstancesByStatus   map[string]int `json:"instances_by_status"`
+	MemoryGB            int            `json:"memory_gb"`
+	RunningMemoryGB     int            `json:"running_memory_gb"`
+	CustomerManagedKeys int            `json:"customer_managed_keys"`
+	DataApis            int            `json:"data_apis"`
+	Sessions            int            `json:"sessions"`
+	SessionsByStatus    map[string]int `json:"sessions_by_status"`
+	SessionsMemoryGB    int            `json:"sessions_memory_gb"`
+}
+
+// A resource that refers to a resource that no longer exists or that nothing refers to
+type Orphan struct {
+	Kind       string `json:"kind"`
+	Credential string `json:"credential"`
+	Id         string `json:"id"`
+	Name       string `json:"name"`
+	Reason     string `json:"reason"`
+}
+
+type collector struct {
+	cfg         *clicfg.Config
+	concurrency int
+	dataApis    bool
+
+	mu     sync.Mutex
+	report *Report
+}
+
+func (c *collector) add(rows *[]map[string]any, row map[string]any) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	*rows = append(*rows, row)
+}
+
+func (c *collector) fail(credential *credentials.AuraCredential, err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.report.Errors = append(c.report.Errors, fmt.Sprintf("credential %s: %s", credential.Name, err))
+}
+
+func (c *collector) get(credential *credentials.AuraCredential, path string, queryParams map[string]string) ([]map[string]any, error) {
+	resBody, _, err := api.MakeRequest(c.cfg, path, &api.RequestConfig{
+		Method:      http.MethodGet,
+		QueryParams: queryParams,
+		Credential:  credential,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return api.ParseBody(resBody).AsArray(), nil
+}
+
+// Collects the resources all credentials can see. Failed requests are recorded in the errors of the report instead of stopping the collection.
+func collect(cfg *clicfg.Config, creds []*credentials.AuraCredential, concurrency int) *Report {
+	c := &collector{
+		cfg:         cfg,
+		concurrency: concurrency,
+		dataApis:    cfg.Aura.AuraBetaEnabled(),
+		report: &Report{
+			Credentials:         []string{},
+			Tenants:             []map[string]any{},
+			Instances:           []map[string]any{},
+			CustomerManagedKeys: []map[string]any{},
+			Sessions:            []map[string]any{},
+			Orphans:             []Orphan{},
+			Errors:              []string{},
+		},
+	}
+	if c.dataApis {
+		c.report.DataApis = []map[string]any{}
+	}
+
+	// Access tokens are stored in the credentials file when they are retrieved, which must not happen concurrently,
+	// so each credential lists its tenants before any concurrent request is made
+	available := []*credentials.AuraCredential{}
+	for _, credential := range creds {
+		c.report.Credentials = append(c.report.Credentials, credential.Name)
+		if !credential.HasValidAccessToken() {
+			grant, err := api.RequestToken(cfg, credential)
+			if err != nil {
+				c.fail(credential, fmt.Errorf("cannot retrieve an access token: %w", err))
+				continue
+			}
+			credential = cfg.Credentials.Aura.UpdateAccessToken(credential, grant.AccessToken, grant.ExpiresIn)
+		}
+		tenants, err := c.get(credential, "/tenants", nil)
+		if err != nil {
+			c.fail(credential, err)
+			continue
+		}
+		for _, tenant := range tenants {
+			c.add(&c.report.Tenants, map[string]any{"credential": credential.Name, "id": tenant["id"], "name": tenant["name"]})
+		}
+		available = append(available, credential)
+	}
+
+	listings := []struct {
+		path string
+		rows *[]map[string]any
+	}{
+		{"/instances", &c.report.Instances},
+		{"/customer-managed-keys", &c.report.CustomerManagedKeys},
+		{"/graph-analytics/sessions", &c.report.Sessions},
+	}
+	utils.RunConcurrently(concurrency, len(available)*len(listings), func(i int) {
+		credential := available[i/len(listings)]
+		listing := listings[i%len(listings)]
+		resources, err := c.get(credential, listing.path, nil)
+		if err != nil {
+			c.fail(credential, err)
+			return
+		}
+		for _, resource := range resources {
+			resource["credential"] = credential.Name
+			c.add(listing.rows, resource)
+		}
+	})
+
+	c.collectInstanceDetails(available)
+
+	c.report.sort()
+	c.report.Totals = c.report.totals()
+	c.report.Orphans = c.report.orphans()
+	return c.report
+}
+
+// Adds the details, today's snapshots and, when beta is enabled, the Data APIs of each instance
+func (c *collector) collectInstanceDetails(available []*credentials.AuraCredential) {
+	credentialsByName := map[string]*credentials.AuraCredential{}
+	for _, credential := range available {
+		credentialsByName[credential.Name] = credential
+	}
+
+	// The details of each instance are collected concurrently into their own map and merged into the instance afterwards,
+	// so that the instances are not written concurrently
+	details := make([]map[string]any, len(c.report.Instances))
+	tasks := []func(){}
+	for i, instance := range c.report.Instances {
+		credential := credentialsByName[fmt.Sprint(instance["credential"])]
+		instanceId := fmt.Sprint(instance["id"])
+		details[i] = map[string]any{}
+		instanceDetails := details[i]
+
+		tasks = append(tasks, func() {
+			resBody, _, err := api.MakeRequest(c.cfg, fmt.Sprintf("/instances/%s", instanceId), &api.RequestConfig{
+				Method:     http.MethodGet,
+				Credential: credential,
+			})
+			if err != nil {
+				c.fail(credential, err)
+				return
+			}
+			fetched, err := api.ParseBody(resBody).GetSingleOrError()
+			if err != nil {
+				c.fail(credential, err)
+				return
+			}
+			c.mu.Lock()
+			defer c.mu.Unlock()
+			for _, key := range []string{"status", "type", "cloud_provider", "region", "memory", "storage", "customer_managed_key_id"} {
+				if value, ok := fetched[key]; ok {
+					instanceDetails[key] = value
+				}
+			}
+		})
+
+		tasks = append(tasks, func() {
+			// Without a date, the snapshots of the current day are listed
+			list, err := c.get(credential, fmt.Sprintf("/instances/%s/snapshots", instanceId), nil)
+			if err != nil {
+				c.fail(credential, err)
+				return
+			}
+			latest := ""
+			for _, snapshot := range list {
+				if snapshot["status"] != api.SnapshotStatusCompleted {
+					continue
+				}
+				// RFC 3339 timestamps in UTC sort like strings
+				if timestamp := fmt.Sprint(snapshot["timestamp"]); timestamp > latest {
+					latest = timestamp
+				}
+			}
+			c.mu.Lock()
+			defer c.mu.Unlock()
+			instanceDetails["snapshots_today"] = len(list)
+			instanceDetails["latest_snapshot_at"] = latest
+		})
+
+		if c.dataApis {
+			tasks = append(tasks, func() {
+				list, err := c.get(credential, fmt.Sprintf("/instances/%s/data-apis/graphql", instanceId), nil)
+				if err != nil {
+					c.fail(credential, err)
+					return
+				}
+				for _, dataApi := range list {
+					dataApi["credential"] = credential.Name
+					dataApi["instance_id"] = instanceId
+					c.add(&c.report.DataApis, dataApi)
+				}
+			})
+		}
+	}
+
+	utils.RunConcurrently(c.concurrency, len(tasks), func(i int) {
+		tasks[i]()
+	})
+
+	for i, instance := range c.report.Instances {
+		maps.Copy(instance, details[i])
+	}
+}
+
+func (r *Report) sort() {
+	byName := func(a, b map[string]any) int {
+		return cmp.Or(
+			strings.Compare(fmt.Sprint(a["credential"]), fmt.Sprint(b["credential"])),
+			strings.Compare(fmt.Sprint(a["tenant_id"]), fmt.Sprint(b["tenant_id"])),
+			strings.Compare(fmt.Sprint(a["instance_id"]), fmt.Sprint(b["instance_id"])),
+			strings.Compare(fmt.Sprint(a["name"]), fmt.Sprint(b["name"])),
+			strings.Compare(fmt.Sprint(a["id"]), fmt.Sprint(b["id"])),
+		)
+	}
+	for _, rows := range [][]map[string]any{r.Tenants, r.Instances, r.CustomerManagedKeys, r.DataApis, r.Sessions} {
+		slices.SortFunc(rows, byName)
+	}
+	slices.Sort(r.Errors)
+}
+
+func (r *Report) totals() Totals {
+	totals := Totals{
+		Tenants:             len(r.Tenants),
+		Instances:           len(r.Instances),
+		InstancesByStatus:   map[string]int{},
+		CustomerManagedKeys: len(r.CustomerManagedKeys),
+		DataApis:            len(r.DataApis),
+		Sessions:            len(r.Sessions),
+		SessionsByStatus:    map[string]int{},
+	}
+	for _, instance := range r.Instances {
+		status := fmt.Sprint(instance["status"])
+		memory := memoryInGB(instance["memory"])
+		totals.InstancesByStatus[status]++
+		totals.MemoryGB += memory
+		if status == api.InstanceStatusRunning {
+			totals.RunningMemoryGB += memory
+		}
+	}
+	for _, session := range r.Sessions {
+		totals.SessionsByStatus[fmt.Sprint(session["status"])]++
+		totals.SessionsMemoryGB += memoryInGB(session["memory"])
+	}
+	return totals
+}
+
+// Sessions attached to an instance that does not exist anymore, and customer managed keys that no instance uses
+func (r *Report) orphans() []Orphan {
+	instances := map[string]bool{}
+	keysInUse := map[string]bool{}
+	for _, instance := range r.Instances {
+		instances[fmt.Sprint(instance["id"])] = true
+		if keyId, ok := instance["customer_managed_key_id"].(string); ok && keyId != "" {
+			keysInUse[keyId] = true
+		}
+	}
+
+	orphans := []Orphan{}
+	for _, session := range r.Sessions {
+		instanceId, ok := session["instance_id"].(string)
+		if !ok || instanceId == "" || instances[instanceId] {
+			continue
+		}
+		orphans = append(orphans, Orphan{
+			Kind:       kindSession,
+			Credential: fmt.Sprint(session["credential"]),
+			Id:         fmt.Sprint(session["id"]),
+			Name:       fmt.Sprint(session["name"]),
+			Reason:     fmt.Sprintf("attached to instance %s, which does not exist", instanceId),
+		})
+	}
+	for _, key := range r.CustomerManagedKeys {
+		if keysInUse[fmt.Sprint(key["id"])] {
+			continue
+		}
+		orphans = append(orphans, Orphan{
+			Kind:       kindCustomerManagedKey,
+			Credential: fmt.Sprint(key["credential"]),
+			Id:         fmt.Sprint(key["id"]),
+			Name:       fmt.Sprint(key["name"]),
+			Reason:     "not used by any instance",
+		})
+	}
+	return orphans
+}
+
+// Returns the size of a memory value such as 8GB, 0 when it is not given in GB
+func memoryInGB(memory any) int {
+	value, err := strconv.Atoi(strings.TrimSuffix(fmt.Sprint(memory), "GB"))
+	if err != nil {
+		return 0
+	}
+	return value
+}
@@ -0,0 +1,236 @@
+// Copyright (c) "Neo4j"
+// Neo4j Sweden AB [http://neo4j.com]
+
+package inventory
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/neo4j/cli/common/clicfg"
+	"github.com/neo4j/cli/common/clicfg/credentials"
+	"github.com/neo4j/cli/common/clierr"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/flags"
+	"github.com/spf13/cobra"
+)
+
+func NewCmd(cfg *clicfg.Config) *cobra.Command {
+	var (
+		credentialNames []string
+		format          = flags.ReportFormat("json")
+		concurrency     int
+	)
+
+	const (
+		credentialsFlag = "credentials"
+		formatFlag      = "format"
+		concurrencyFlag = "concurrency"
+	)
+
+	cmd := &cobra.Command{
+		Use:   "inventory",
+		Short: "Reports all Aura resources the stored credentials can see",
+		Long: `Collects the tenants, instances, customer managed keys and Graph Analytics sessions of all stored credentials, or of the credentials given with --credentials, into one report. The details and the snapshots of the current day of each instance are included, as are the GraphQL Data APIs of each instance when beta is enabled.
+
+The report contains totals of the instance memory, of running instances only and overall, and counts of instances and sessions per status. It also lists orphans: sessions attached to an instance that does not exist anymore and customer managed keys that no instance uses.
+
+The report is printed as json, as csv with one row per resource, or as markdown with a table per kind of resource.
+
+Requests are made concurrently, at most --concurrency at a time. A request that fails does not stop the inventory, its error is included in the report and the command exits with a non-zero status.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if concurrency < 1 {
+				return clierr.NewUsageError(`invalid argument "%d" for "--%s" flag: must be at least 1`, concurrency, concurrencyFlag)
+			}
+
+			var creds []*credentials.AuraCredential
+			if len(credentialNames) == 0 {
+				creds = cfg.Credentials.Aura.List()
+			}
+			for _, name := range credentialNames {
+				credential, err := cfg.Credentials.Aura.Get(name)
+				if err != nil {
+					return err
+				}
+				creds = append(creds, credential)
+			}
+			if len(creds) == 0 {
+				return clierr.NewUsageError("no credentials are stored, add one with credential add")
+			}
+
+			cmd.SilenceUsage = true
+			report := collect(cfg, creds, concurrency)
+
+			switch format {
+			case "csv":
+				printCsv(cmd, report)
+			case "markdown":
+				printMarkdown(cmd, report)
+			default:
+				bytes, err := json.MarshalIndent(report, "", "\t")
+				if err != nil {
+					return err
+				}
+				cmd.Println(string(bytes))
+			}
+
+			if len(report.Errors) > 0 {
+				return clierr.NewUpstreamError("the inventory is incomplete, %d requests failed", len(report.Errors))
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringSliceVar(&credentialNames, credentialsFlag, nil, "Comma separated names of the credentials to include, all stored credentials are included when not set")
+	cmd.Flags().Var(&format, formatFlag, `Format of the report, one of "json", "csv" or "markdown"`)
+	cmd.Flags().IntVar(&concurrency, concurrencyFlag, 4, "Maximum number of requests made at the same time")
+
+	return cmd
+}
+
+// One row per resource, with the columns all kinds of resources have in common
+func printCsv(cmd *cobra.Command, report *Report) {
+	orphaned := map[string]bool{}
+	for _, orphan := range report.Orphans {
+		orphaned[orphan.Kind+"/"+orphan.Id] = true
+	}
+
+	var b strings.Builder
+	w := csv.NewWriter(&b)
+	fields := []string{"kind", "credential", "tenant_id", "instance_id", "id", "name", "status", "memory", "orphaned"}
+	if err := w.Write(fields); err != nil {
+		panic(err)
+	}
+	for _, kind := range kinds(report) {
+		for _, row := range kind.rows {
+			record := []string{kind.kind}
+			for _, field := range fields[1 : len(fields)-1] {
+				record = append(record, value(row, field))
+			}
+			record = append(record, fmt.Sprint(orphaned[kind.kind+"/"+value(row, "id")]))
+			if err := w.Write(record); err != nil {
+				panic(err)
+			}
+		}
+	}
+	w.Flush()
+	if err := w.Error(); err != nil {
+		panic(err)
+	}
+	cmd.Print(b.String())
+}
+
+func printMarkdown(cmd *cobra.Command, report *Report) {
+	var b strings.Builder
+	b.WriteString("# Aura inventory\n\n")
+	fmt.Fprintf(&b, "Credentials: %s\n", strings.Join(report.Credentials, ", "))
+
+	for _, kind := range kinds(report) {
+		fmt.Fprintf(&b, "\n## %s\n\n", kind.title)
+		if len(kind.rows) == 0 {
+			b.WriteString("None\n")
+			continue
+		}
+		rows := [][]string{}
+		for _, row := range kind.rows {
+			record := []string{}
+			for _, field := range kind.fields {
+				record = append(record, value(row, field))
+			}
+			rows = append(rows, record)
+		}
+		b.WriteString(markdownTable(kind.fields, rows))
+	}
+
+	totals := report.Totals
+	b.WriteString("\n## Totals\n\n")
+	b.WriteString(markdownTable([]string{"total", "value"}, [][]string{
+		{"tenants", fmt.Sprint(totals.Tenants)},
+		{"instances", fmt.Sprint(totals.Instances)},
+		{"instances by status", countsByStatus(totals.InstancesByStatus)},
+		{"memory", fmt.Sprintf("%dGB", totals.MemoryGB)},
+		{"memory of running instances", fmt.Sprintf("%dGB", totals.RunningMemoryGB)},
+		{"customer managed keys", fmt.Sprint(totals.CustomerManagedKeys)},
+		{"data APIs", fmt.Sprint(totals.DataApis)},
+		{"sessions", fmt.Sprint(totals.Sessions)},
+		{"sessions by status", countsByStatus(totals.SessionsByStatus)},
+		{"memory of sessions", fmt.Sprintf("%dGB", totals.SessionsMemoryGB)},
+	}))
+
+	b.WriteString("\n## Orphans\n\n")
+	if len(report.Orphans) == 0 {
+		b.WriteString("None\n")
+	} else {
+		rows := [][]string{}
+		for _, orphan := range report.Orphans {
+			rows = append(rows, []string{orphan.Kind, orphan.Credential, orphan.Id, orphan.Name, orphan.Reason})
+		}
+		b.WriteString(markdownTable([]string{"kind", "credential", "id", "name", "reason"}, rows))
+	}
+
+	if len(report.Errors) > 0 {
+		b.WriteString("\n## Errors\n\n")
+		for _, err := range report.Errors {
+			fmt.Fprintf(&b, "- %s\n", err)
+		}
+	}
+
+	cmd.Print(b.String())
+}
+
+type resourceKind struct {
+	kind   string
+	title  string
+	fields []string
+	rows   []map[string]any
+}
+
+func kinds(report *Report) []resourceKind {
+	kinds := []resourceKind{
+		{kindTenant, "Tenants", []string{"credential", "id", "name"}, report.Tenants},
+		{kindInstance, "Instances", []string{"credential", "tenant_id", "id", "name", "status", "type", "cloud_provider", "region", "memory", "snapshots_today", "latest_snapshot_at"}, report.Instances},
+		{kindCustomerManagedKey, "Customer managed keys", []string{"credential", "tenant_id", "id", "name"}, report.CustomerManagedKeys},
+	}
+	if report.DataApis != nil {
+		kinds = append(kinds, resourceKind{kindDataApi, "Data APIs", []string{"credential", "instance_id", "id", "name", "status"}, report.DataApis})
+	}
+	return append(kinds, resourceKind{kindSession, "Sessions", []string{"credential", "tenant_id", "id", "name", "status", "memory", "instance_id"}, report.Sessions})
+}
+
+func value(row map[string]any, field string) string {
+	value, ok := row[field]
+	if !ok || value == nil {
+		return ""
+	}
+	return fmt.Sprint(value)
+}
+
+func markdownTable(header []string, rows [][]string) string {
+	t := table.NewWriter()
+	headerRow := table.Row{}
+	for _, h := range header {
+		headerRow = append(headerRow, h)
+	}
+	t.AppendHeader(headerRow)
+	for _, row := range rows {
+		tableRow := table.Row{}
+		for _, cell := range row {
+			tableRow = append(tableRow, cell)
+		}
+		t.AppendRow(tableRow)
+	}
+	return t.RenderMarkdown() + "\n"
+}
+
+func countsByStatus(counts map[string]int) string {
+	parts := []string{}
+	for _, status := range slices.Sorted(maps.Keys(counts)) {
+		parts = append(parts, fmt.Sprintf("%s: %d", status, counts[status]))
+	}
+	return strings.Join(parts, ", ")
+}
@@ -0,0 +1,189 @@
+// Copyright (c) "Neo4j"
+// Neo4j Sweden AB [http://neo4j.com]
+
+package inventory_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/test/testutils"
+	"github.com/stretchr/testify/assert"
+)
+
+func mockInventory(helper *testutils.AuraTestHelper) {
+	helper.NewRequestHandlerMock("GET /v1/tenants", http.StatusOK, `{"data": [{"id": "YOUR_TENANT_ID", "name": "Production"}]}`)
+	helper.NewRequestHandlerMock("GET /v1/instances", http.StatusOK, `{"data": [
+		{"id": "db1d1234", "name": "orders", "tenant_id": "YOUR_TENANT_ID", "cloud_provider": "gcp"},
+		{"id": "db2d1234", "name": "archive", "tenant_id": "YOUR_TENANT_ID", "cloud_provider": "gcp"}
+	]}`)
+	helper.NewRequestHandlerMock("GET /v1/instances/db1d1234", http.StatusOK, `{"data": {"id": "db1d1234", "name": "orders", "tenant_id": "YOUR_TENANT_ID", "status": "running", "type": "enterprise-db", "cloud_provider": "gcp", "region": "europe-west1", "memory": "8GB", "storage": "16GB", "customer_managed_key_id": "cmk1"}}`)
+	helper.NewRequestHandlerMock("GET /v1/instances/db2d1234", http.StatusOK, `{"data": {"id": "db2d1234", "name": "archive", "tenant_id": "YOUR_TENANT_ID", "status": "paused", "type": "professional-db", "cloud_provider": "gcp", "region": "europe-west1", "memory": "4GB", "storage": "8GB"}}`)
+	helper.NewRequestHandlerMock("GET /v1/instances/db1d1234/snapshots", http.StatusOK, `{"data": [
+		{"snapshot_id": "snap1", "instance_id": "db1d1234", "profile": "Scheduled", "status": "Completed", "timestamp": "2026-10-19T01:00:00Z"},
+		{"snapshot_id": "snap2", "instance_id": "db1d1234", "profile": "Scheduled", "status": "Completed", "timestamp": "2026-10-19T02:00:00Z"},
+		{"snapshot_id": "snap3", "instance_id": "db1d1234", "profile": "Scheduled", "status": "Failed", "timestamp": "2026-10-19T03:00:00Z"}
+	]}`)
+	helper.NewRequestHandlerMock("GET /v1/instances/db2d1234/snapshots", http.StatusOK, `{"data": []}`)
+	helper.NewRequestHandlerMock("GET /v1/customer-managed-keys", http.StatusOK, `{"data": [
+		{"id": "cmk1", "name": "orders-key", "tenant_id": "YOUR_TENANT_ID"},
+		{"id": "cmk2", "name": "old-key", "tenant_id": "YOUR_TENANT_ID"}
+	]}`)
+	helper.NewRequestHandlerMock("GET /v1/graph-analytics/sessions", http.StatusOK, `{"data": [
+		{"id": "sess1", "name": "pagerank", "memory": "8GB", "instance_id": "db1d1234", "status": "Ready", "tenant_id": "YOUR_TENANT_ID", "expiry_date": "2026-10-20T00:00:00Z"},
+		{"id": "sess2", "name": "louvain", "memory": "16GB", "instance_id": "db9d1234", "status": "Ready", "tenant_id": "YOUR_TENANT_ID", "expiry_date": "2026-10-20T00:00:00Z"}
+	]}`)
+}
+
+func TestInventory(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	mockInventory(&helper)
+
+	helper.ExecuteCommand("inventory")
+
+	helper.AssertErr("")
+	assert.JSONEq(t, `{
+		"credentials": ["test-cred"],
+		"customer_managed_keys": [
+			{"credential": "test-cred", "id": "cmk2", "name": "old-key", "tenant_id": "YOUR_TENANT_ID"},
+			{"credential": "test-cred", "id": "cmk1", "name": "orders-key", "tenant_id": "YOUR_TENANT_ID"}
+		],
+		"errors": [],
+		"instances": [
+			{
+				"cloud_provider": "gcp",
+				"credential": "test-cred",
+				"id": "db2d1234",
+				"latest_snapshot_at": "",
+				"memory": "4GB",
+				"name": "archive",
+				"region": "europe-west1",
+				"snapshots_today": 0,
+				"status": "paused",
+				"storage": "8GB",
+				"tenant_id": "YOUR_TENANT_ID",
+				"type": "professional-db"
+			},
+			{
+				"cloud_provider": "gcp",
+				"credential": "test-cred",
+				"customer_managed_key_id": "cmk1",
+				"id": "db1d1234",
+				"latest_snapshot_at": "2026-10-19T02:00:00Z",
+				"memory": "8GB",
+				"name": "orders",
+				"region": "europe-west1",
+				"snapshots_today": 3,
+				"status": "running",
+				"storage": "16GB",
+				"tenant_id": "YOUR_TENANT_ID",
+				"type": "enterprise-db"
+			}
+		],
+		"orphans": [
+			{"credential": "test-cred", "id": "sess2", "kind": "session", "name": "louvain", "reason": "attached to instance db9d1234, which does not exist"},
+			{"credential": "test-cred", "id": "cmk2", "kind": "customer_managed_key", "name": "old-key", "reason": "not used by any instance"}
+		],
+		"sessions": [
+			{"credential": "test-cred", "expiry_date": "2026-10-20T00:00:00Z", "id": "sess1", "instance_id": "db1d1234", "memory": "8GB", "name": "pagerank", "status": "Ready", "tenant_id": "YOUR_TENANT_ID"},
+			{"credential": "test-cred", "expiry_date": "2026-10-20T00:00:00Z", "id": "sess2", "instance_id": "db9d1234", "memory": "16GB", "name": "louvain", "status": "Ready", "tenant_id": "YOUR_TENANT_ID"}
+		],
+		"tenants": [
+			{"credential": "test-cred", "id": "YOUR_TENANT_ID", "name": "Production"}
+		],
+		"totals": {
+			"customer_managed_keys": 2,
+			"data_apis": 0,
+			"instances": 2,
+			"instances_by_status": {"paused": 1, "running": 1},
+			"memory_gb": 12,
+			"running_memory_gb": 8,
+			"sessions": 2,
+			"sessions_by_status": {"Ready": 2},
+			"sessions_memory_gb": 24,
+			"tenants": 1
+		}
+	}`, helper.PrintOut())
+}
+
+func TestInventoryAsCsv(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	mockInventory(&helper)
+
+	helper.ExecuteCommand("inventory --format csv")
+
+	helper.AssertErr("")
+	helper.AssertOut(`kind,credential,tenant_id,instance_id,id,name,status,memory,orphaned
+tenant,test-cred,,,YOUR_TENANT_ID,Production,,,false
+instance,test-cred,YOUR_TENANT_ID,,db2d1234,archive,paused,4GB,false
+instance,test-cred,YOUR_TENANT_ID,,db1d1234,orders,running,8GB,false
+customer_managed_key,test-cred,YOUR_TENANT_ID,,cmk2,old-key,,,true
+customer_managed_key,test-cred,YOUR_TENANT_ID,,cmk1,orders-key,,,false
+session,test-cred,YOUR_TENANT_ID,db1d1234,sess1,pagerank,Ready,8GB,false
+session,test-cred,YOUR_TENANT_ID,db9d1234,sess2,louvain,Ready,16GB,true`)
+}
+
+func TestInventoryAsMarkdownWithDataApis(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.SetConfigValue("aura.beta-enabled", true)
+	helper.NewRequestHandlerMock("GET /v1beta5/tenants", http.StatusOK, `{"data": [{"id": "YOUR_TENANT_ID", "name": "Production"}]}`)
+	helper.NewRequestHandlerMock("GET /v1beta5/instances", http.StatusOK, `{"data": [{"id": "db1d1234", "name": "orders", "tenant_id": "YOUR_TENANT_ID", "cloud_provider": "gcp"}]}`)
+	helper.NewRequestHandlerMock("GET /v1beta5/instances/db1d1234", http.StatusOK, `{"data": {"id": "db1d1234", "name": "orders", "tenant_id": "YOUR_TENANT_ID", "status": "running", "type": "enterprise-db", "cloud_provider": "gcp", "region": "europe-west1", "memory": "8GB"}}`)
+	helper.NewRequestHandlerMock("GET /v1beta5/instances/db1d1234/snapshots", http.StatusOK, `{"data": [{"snapshot_id": "snap1", "status": "Completed", "timestamp": "2026-10-19T01:00:00Z"}]}`)
+	helper.NewRequestHandlerMock("GET /v1beta5/instances/db1d1234/data-apis/graphql", http.StatusOK, `{"data": [{"id": "api1", "name": "orders-api", "status": "ready", "url": "https://api1.example.com/graphql"}]}`)
+	helper.NewRequestHandlerMock("GET /v1beta5/customer-managed-keys", http.StatusOK, `{"data": []}`)
+	helper.NewRequestHandlerMock("GET /v1beta5/graph-analytics/sessions", http.StatusInternalServerError, `{"errors": [{"message": "internal error", "reason": "internal-error"}]}`)
+
+	helper.ExecuteCommand("inventory --format markdown")
+
+	helper.AssertErr("Error: the inventory is incomplete, 1 requests failed")
+	out := helper.PrintOut()
+	assert.Contains(t, out, "# Aura inventory\n\nCredentials: test-cred\n")
+	assert.Contains(t, out, `## Instances
+
+| credential | tenant_id | id | name | status | type | cloud_provider | region | memory | snapshots_today | latest_snapshot_at |
+| --- | --- | --- | --- | --- | --- | --- | --- | --- | --- | --- |
+| test-cred | YOUR_TENANT_ID | db1d1234 | orders | running | enterprise-db | gcp | europe-west1 | 8GB | 1 | 2026-10-19T01:00:00Z |
+`)
+	assert.Contains(t, out, "## Customer managed keys\n\nNone\n")
+	assert.Contains(t, out, `## Data APIs
+
+| credential | instance_id | id | name | status |
+| --- | --- | --- | --- | --- |
+| test-cred | db1d1234 | api1 | orders-api | ready |
+`)
+	assert.Contains(t, out, "| memory of running instances | 8GB |\n")
+	assert.Contains(t, out, "## Errors\n\n- credential test-cred: ")
+}
+
+func TestInventoryWithSelectedCredentials(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.SetCredentialsValue("aura.credentials.-1", map[string]any{"name": "other-cred", "access-token": "dsa", "token-expiry": 123})
+	tenantsMock := helper.NewRequestHandlerMock("GET /v1/tenants", http.StatusOK, `{"data": []}`)
+	helper.NewRequestHandlerMock("GET /v1/instances", http.StatusOK, `{"data": []}`)
+	helper.NewRequestHandlerMock("GET /v1/customer-managed-keys", http.StatusOK, `{"data": []}`)
+	helper.NewRequestHandlerMock("GET /v1/graph-analytics/sessions", http.StatusOK, `{"data": []}`)
+
+	helper.ExecuteCommand("inventory --credentials other-cred --format csv")
+
+	helper.AssertErr("")
+	tenantsMock.AssertCalledTimes(1)
+	helper.AssertOut("kind,credential,tenant_id,instance_id,id,name,status,memory,orphaned")
+}
+
+func TestInventoryWithUnknownCredential(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.ExecuteCommand("inventory --credentials missing")
+
+	helper.AssertErr("Error: could not find credential with name missing")
+}
@@ -0,0 +1,152 @@
+// Copyright (c) "Neo4j"
+// Neo4j Sweden AB [http://neo4j.com]
+
+package scheduler
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// A five field cron expression: minute, hour, day of month, month and day of week
+type CronSchedule struct {
+	minutes     []bool
+	hours       []bool
+	daysOfMonth []bool
+	months      []bool
+	daysOfWeek  []bool
+	// Whether the day of month and day of week fields were restricted, a day matches either of them when both are
+	daysOfMonthSet bool
+	daysOfWeekSet  bool
+}
+
+var dayNames = []string{"sun", "mon", "tue", "wed", "thu", "fri", "sat"}
+
+var monthNames = []string{"jan", "feb", "mar", "apr", "may", "jun", "jul", "aug", "sep", "oct", "nov", "dec"}
+
+// Parses a cron expression such as "30 7 * * mon-fri". Each field is *, a value, a range a-b, or a list of them separated by commas, optionally followed by a step /n.
+// Days of the week and months can also be given by their three letter English names, and day 7 is Sunday like day 0.
+func ParseCronSchedule(expression string) (*CronSchedule, error) {
+	fields := strings.Fields(expression)
+	if len(fields) != 5 {
+		return nil, fmt.Errorf("invalid schedule %q: must have 5 fields, minute hour day-of-month month day-of-week", expression)
+	}
+
+	schedule := &CronSchedule{}
+	var err error
+	if schedule.minutes, err = parseCronField(fields[0], 0, 59, nil); err != nil {
+		return nil, fmt.Errorf("invalid minute in schedule %q: %w", expression, err)
+	}
+	if schedule.hours, err = parseCronField(fields[1], 0, 23, nil); err != nil {
+		return nil, fmt.Errorf("invalid hour in schedule %q: %w", expression, err)
+	}
+	if schedule.daysOfMonth, err = parseCronField(fields[2], 1, 31, nil); err != nil {
+		return nil, fmt.Errorf("invalid day of month in schedule %q: %w", expression, err)
+	}
+	if schedule.months, err = parseCronField(fields[3], 1, 12, monthNames); err != nil {
+		return nil, fmt.Errorf("invalid month in schedule %q: %w", expression, err)
+	}
+	if schedule.daysOfWeek, err = parseCronField(fields[4], 0, 7, dayNames); err != nil {
+		return nil, fmt.Errorf("invalid day of week in schedule %q: %w", expression, err)
+	}
+	if schedule.daysOfWeek[7] {
+		schedule.daysOfWeek[0] = true
+	}
+	schedule.daysOfMonthSet = !strings.HasPrefix(fields[2], "*")
+	schedule.daysOfWeekSet = !strings.HasPrefix(fields[4], "*")
+
+	return schedule, nil
+}
+
+// Returns a slice indexed by value, which is true for every value the field matches
+func parseCronField(field string, min int, max int, names []string) ([]bool, error) {
+	matches := make([]bool, max+1)
+	for _, part := range strings.Split(field, ",") {
+		valueRange, stepValue, hasStep := strings.Cut(part, "/")
+
+		step := 1
+		if hasStep {
+			parsed, err := strconv.Atoi(stepValue)
+			if err != nil || parsed < 1 {
+				return nil, fmt.Errorf("invalid step %q", stepValue)
+			}
+			step = parsed
+		}
+
+		var start, end int
+		if valueRange == "*" {
+			start, end = min, max
+		} else {
+			startValue, endValue, isRange := strings.Cut(valueRange, "-")
+			var err error
+			if start, err = parseCronValue(startValue, min, max, names); err != nil {
+				return nil, err
+			}
+			end = start
+			if isRange {
+				if end, err = parseCronValue(endValue, min, max, names); err != nil {
+					return nil, err
+				}
+			} else if hasStep {
+				end = max
+			}
+			if end < start {
+				return nil, fmt.Errorf("invalid range %q", valueRange)
+			}
+		}
+
+		for value := start; value <= end; value += step {
+			matches[value] = true
+		}
+	}
+	return matches, nil
+}
+
+func parseCronValue(value string, min int, max int, names []string) (int, error) {
+	for i, name := range names {
+		if strings.EqualFold(value, name) {
+			// Month names start at 1, day names at 0
+			return i + min, nil
+		}
+	}
+
+	parsed, err := strconv.Atoi(value)
+	if err != nil || parsed < min || parsed > max {
+		return 0, fmt.Errorf("invalid value %q: must be between %d and %d", value, min, max)
+	}
+	return parsed, nil
+}
+
+func (s *CronSchedule) matchesDay(t time.Time) bool {
+	dayOfMonth := s.daysOfMonth[t.Day()]
+	dayOfWeek := s.daysOfWeek[int(t.Weekday())]
+	if s.daysOfMonthSet && s.daysOfWeekSet {
+		return dayOfMonth || dayOfWeek
+	}
+	return dayOfMonth && dayOfWeek
+}
+
+// Returns the first time after t the schedule matches, in the location of t. Returns the zero time when the schedule never matches, e.g. for February 30th.
+func (s *CronSchedule) Next(t time.Time) time.Time {
+	t = t.Truncate(time.Minute).Add(time.Minute)
+	// Any schedule that can match does so within 8 years, the longest gap being between two February 29ths around a century year like 2100
+	limit := t.AddDate(9, 0, 0)
+	for t.Before(limit) {
+		if !s.months[int(t.Month())] || !s.matchesDay(t) {
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, t.Location())
+			continue
+		}
+		if !s.hours[t.Hour()] {
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, t.Location())
+			continue
+		}
+		if !s.minutes[t.Minute()] {
+			t = t.Add(time.Minute)
+			continue
+		}
+		return t
+	}
+	return time.Time{}
+}
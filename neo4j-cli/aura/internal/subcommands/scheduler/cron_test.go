@@ -0,0 +1,63 @@
+// Copyright (c) "Neo4j"
+// Neo4j Sweden AB [http://neo4j.com]
+
+package scheduler_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/subcommands/scheduler"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCronScheduleNext(t *testing.T) {
+	stockholm, err := time.LoadLocation("Europe/Stockholm")
+	assert.Nil(t, err)
+
+	// Friday
+	from := time.Date(2026, 10, 16, 18, 30, 15, 0, stockholm)
+
+	testCases := []struct {
+		expression string
+		expected   time.Time
+	}{
+		{"0 19 * * *", time.Date(2026, 10, 16, 19, 0, 0, 0, stockholm)},
+		{"30 7 * * mon-fri", time.Date(2026, 10, 19, 7, 30, 0, 0, stockholm)},
+		{"30 7 * * 1-5", time.Date(2026, 10, 19, 7, 30, 0, 0, stockholm)},
+		{"*/15 * * * *", time.Date(2026, 10, 16, 18, 45, 0, 0, stockholm)},
+		{"0 0 1 jan *", time.Date(2027, 1, 1, 0, 0, 0, 0, stockholm)},
+		{"0 9 * * 7", time.Date(2026, 10, 18, 9, 0, 0, 0, stockholm)},
+		{"0 9 1 * sat", time.Date(2026, 10, 17, 9, 0, 0, 0, stockholm)},
+		{"0 12 29 2 *", time.Date(2028, 2, 29, 12, 0, 0, 0, stockholm)},
+		{"0 0 30 2 *", time.Time{}},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.expression, func(t *testing.T) {
+			schedule, err := scheduler.ParseCronSchedule(testCase.expression)
+			assert.Nil(t, err)
+			assert.Equal(t, testCase.expected, schedule.Next(from))
+		})
+	}
+}
+
+func TestParseCronScheduleErrors(t *testing.T) {
+	testCases := []struct {
+		expression    string
+		expectedError string
+	}{
+		{"0 19 * *", `invalid schedule "0 19 * *": must have 5 fields, minute hour day-of-month month day-of-week`},
+		{"60 19 * * *", `invalid minute in schedule "60 19 * * *": invalid value "60": must be between 0 and 59`},
+		{"0 19-7 * * *", `invalid hour in schedule "0 19-7 * * *": invalid range "19-7"`},
+		{"0 19 * * mon/0", `invalid day of week in schedule "0 19 * * mon/0": invalid step "0"`},
+		{"0 19 * foo *", `invalid month in schedule "0 19 * foo *": invalid value "foo": must be between 1 and 12`},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.expression, func(t *testing.T) {
+			_, err := scheduler.ParseCronSchedule(testCase.expression)
+			assert.EqualError(t, err, testCase.expectedError)
+		})
+	}
+}
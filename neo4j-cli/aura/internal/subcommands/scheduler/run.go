@@ -0,0 +1,223 @@
+// Copyright (c) "Neo4j"
+// Neo4j Sweden AB [http://neo4j.com]
+
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/neo4j/cli/common/clicfg"
+	"github.com/neo4j/cli/common/clierr"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/flags"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/subcommands/instance"
+	"github.com/spf13/cobra"
+)
+
+func NewRunCmd(cfg *clicfg.Config) *cobra.Command {
+	var (
+		configFile string
+		once       bool
+		window     time.Duration
+		logFormat  flags.LogFormat = "text"
+	)
+
+	const (
+		configFlag    = "config"
+		onceFlag      = "once"
+		windowFlag    = "window"
+		logFormatFlag = "log-format"
+	)
+
+	cmd := &cobra.Command{
+		Use:   "run",
+		Short: "Runs the scheduler",
+		Long: `This subcommand pauses and resumes instances according to the entries of a YAML schedule file, for example:
+
+  time_zone: Europe/Stockholm
+  retries: 3
+  retry_interval: 1m
+  entries:
+    - name: pause dev
+      action: pause
+      schedule: "0 19 * * *"
+      selector: ["name=^dev-"]
+    - name: resume dev
+      action: resume
+      schedule: "30 7 * * mon-fri"
+      selector: ["name=^dev-"]
+
+The schedule of an entry is a cron expression with the fields minute, hour, day of month, month and day of week, evaluated in the time_zone of the entry, or of the file, or else the local time zone. The selector uses the keys of the --selector flag of instance pause, and all: true applies the entry to all instances. Paused instances are skipped when pausing and running instances when resuming.
+
+An entry whose operation fails for any instance is retried up to retries times, waiting retry_interval in between. Entries that come due while another entry is running or being retried are run as soon as it is done. Every operation is logged to stderr, as text or, with --log-format json, as one JSON object per line.
+
+By default the scheduler runs until it is interrupted. With --once it runs the entries that were due within the last --window and exits, with a non-zero status when any of them failed, which is meant to be called from cron every --window.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			schedule, err := ReadSchedule(cfg, configFile)
+			if err != nil {
+				return err
+			}
+
+			cmd.SilenceUsage = true
+			var handler slog.Handler
+			if logFormat == "json" {
+				handler = slog.NewJSONHandler(cmd.ErrOrStderr(), nil)
+			} else {
+				handler = slog.NewTextHandler(cmd.ErrOrStderr(), nil)
+			}
+			logger := slog.New(handler)
+
+			ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt, syscall.SIGTERM)
+			defer stop()
+
+			if once {
+				return runDue(ctx, cfg, logger, schedule, time.Now(), window)
+			}
+			return runForever(ctx, cfg, logger, schedule)
+		},
+	}
+
+	cmd.Flags().StringVar(&configFile, configFlag, "", "(required) Path to the YAML schedule file")
+	cmd.MarkFlagRequired(configFlag)
+
+	cmd.Flags().BoolVar(&once, onceFlag, false, "Runs the entries due within the last --window and exits")
+
+	cmd.Flags().DurationVar(&window, windowFlag, time.Minute, "How far back --once looks for due entries, should match how often it is called")
+
+	cmd.Flags().Var(&logFormat, logFormatFlag, "The format of the log, text or json")
+
+	return cmd
+}
+
+// Runs the entries that were due after now minus window, returning an error when any of them failed
+func runDue(ctx context.Context, cfg *clicfg.Config, logger *slog.Logger, schedule *Schedule, now time.Time, window time.Duration) error {
+	failed := 0
+	for _, entry := range schedule.Entries {
+		next := entry.next(now.Add(-window))
+		if next.IsZero() || next.After(now) {
+			continue
+		}
+		if err := runEntry(ctx, cfg, logger, schedule, entry); err != nil {
+			failed++
+		}
+	}
+
+	if failed > 0 {
+		return clierr.NewUpstreamError("%d of the due entries failed", failed)
+	}
+	return nil
+}
+
+// Runs the entries whenever they are due, until the context is cancelled.
+// Entries that come due while others are running are run late, once, as soon as those are done.
+func runForever(ctx context.Context, cfg *clicfg.Config, logger *slog.Logger, schedule *Schedule) error {
+	logger.Info("scheduler started", "entries", len(schedule.Entries))
+	last := time.Now()
+	for ctx.Err() == nil {
+		now := time.Now()
+		var next time.Time
+		due := []*Entry{}
+		for _, entry := range schedule.Entries {
+			entryNext := entry.next(last)
+			if entryNext.IsZero() {
+				continue
+			}
+			if !entryNext.After(now) {
+				if now.Sub(entryNext) >= time.Minute {
+					logger.Warn("entry running late", "entry", entry.Name, "due", entryNext.Format(time.RFC3339))
+				}
+				due = append(due, entry)
+			} else if next.IsZero() || entryNext.Before(next) {
+				next = entryNext
+			}
+		}
+
+		if len(due) == 0 {
+			if next.IsZero() {
+				return clierr.NewUsageError("no entry of the schedule is ever due")
+			}
+
+			timer := time.NewTimer(time.Until(next))
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+			case <-timer.C:
+			}
+			continue
+		}
+
+		last = now
+		for _, entry := range due {
+			runEntry(ctx, cfg, logger, schedule, entry)
+		}
+	}
+
+	logger.Info("scheduler stopped")
+	return nil
+}
+
+// Applies the action of the entry, retrying while it fails for any instance
+func runEntry(ctx context.Context, cfg *clicfg.Config, logger *slog.Logger, schedule *Schedule, entry *Entry) error {
+	logger = logger.With("entry", entry.Name, "action", entry.Action)
+	for attempt := 1; ; attempt++ {
+		err := applyEntry(cfg, logger, schedule, entry)
+		if err == nil {
+			return nil
+		}
+
+		if attempt > *schedule.Retries {
+			logger.Error("entry failed", "attempts", attempt, "error", err.Error())
+			return err
+		}
+
+		logger.Warn("retrying entry", "attempt", attempt, "error", err.Error(), "retry_in", schedule.retryInterval.String())
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(schedule.retryInterval):
+		}
+	}
+}
+
+// Applies the action of the entry once, a panic is returned as an error so that it does not stop the scheduler
+func applyEntry(cfg *clicfg.Config, logger *slog.Logger, schedule *Schedule, entry *Entry) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("%v", r)
+		}
+	}()
+
+	rows, err := instance.ApplyBulkAction(cfg, entry.selector, schedule.Concurrency, entry.bulkAction(), false)
+	if err != nil {
+		return err
+	}
+
+	failed := 0
+	for _, row := range rows {
+		attrs := []any{"instance_id", row["id"], "instance_name", row["name"], "status", row["status"]}
+		if row["message"] != "" {
+			attrs = append(attrs, "message", row["message"])
+		}
+
+		switch row["result"] {
+		case instance.BulkResultSucceeded:
+			logger.Info(fmt.Sprintf("instance %s", entryActions[entry.Action]), attrs...)
+		case instance.BulkResultSkipped:
+			logger.Info("instance skipped", attrs...)
+		default:
+			failed++
+			logger.Error("instance failed", attrs...)
+		}
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("%d of %d instances could not be %s", failed, len(rows), entryActions[entry.Action])
+	}
+	return nil
+}
@@ -0,0 +1,201 @@
+// Copyright (c) "Neo4j"
+// Neo4j Sweden AB [http://neo4j.com]
+
+package scheduler_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/test/testutils"
+	"github.com/stretchr/testify/assert"
+)
+
+func mockInstances(helper *testutils.AuraTestHelper) {
+	helper.NewRequestHandlerMock("GET /v1/instances", http.StatusOK, `{
+		"data": [
+			{"id": "2f49c2b3", "name": "dev-api", "tenant_id": "YOUR_TENANT_ID", "cloud_provider": "gcp"},
+			{"id": "d00dfeed", "name": "prod", "tenant_id": "YOUR_TENANT_ID", "cloud_provider": "gcp"}
+		]
+	}`)
+	helper.NewRequestHandlerMock("GET /v1/instances/2f49c2b3", http.StatusOK, `{"data": {"id": "2f49c2b3", "name": "dev-api", "tenant_id": "YOUR_TENANT_ID", "status": "running"}}`)
+	helper.NewRequestHandlerMock("GET /v1/instances/d00dfeed", http.StatusOK, `{"data": {"id": "d00dfeed", "name": "prod", "tenant_id": "YOUR_TENANT_ID", "status": "running"}}`)
+}
+
+func TestRunSchedulerOnce(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.SetFile("schedule.yaml", `
+time_zone: Europe/Stockholm
+entries:
+  - name: pause dev
+    action: pause
+    schedule: "* * * * *"
+    selector: ["name=^dev-"]
+  - name: resume dev
+    action: resume
+    schedule: "0 0 30 2 *"
+    selector: ["name=^dev-"]
+`)
+	mockInstances(&helper)
+	pauseMock := helper.NewRequestHandlerMock("POST /v1/instances/2f49c2b3/pause", http.StatusAccepted, `{"data": {"id": "2f49c2b3", "status": "pausing"}}`)
+	prodMock := helper.NewRequestHandlerMock("POST /v1/instances/d00dfeed/pause", http.StatusAccepted, `{"data": {"id": "d00dfeed", "status": "pausing"}}`)
+
+	helper.ExecuteCommand("scheduler run --config schedule.yaml --once")
+
+	pauseMock.AssertCalledTimes(1)
+	prodMock.AssertCalledTimes(0)
+	helper.AssertOut("")
+	assert.Contains(t, helper.PrintErr(), `level=INFO msg="instance paused" entry="pause dev" action=pause instance_id=2f49c2b3 instance_name=dev-api status=pausing`)
+}
+
+func TestRunSchedulerOnceWithRetryAndJsonLog(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.SetFile("schedule.yaml", `
+retries: 1
+retry_interval: 0s
+entries:
+  - name: pause dev
+    action: pause
+    schedule: "* * * * *"
+    selector: ["name=^dev-"]
+`)
+	helper.NewRequestHandlerMock("GET /v1/instances", http.StatusOK, `{"data": [{"id": "2f49c2b3", "name": "dev-api", "tenant_id": "YOUR_TENANT_ID"}]}`).
+		AddResponse(http.StatusOK, `{"data": [{"id": "2f49c2b3", "name": "dev-api", "tenant_id": "YOUR_TENANT_ID"}]}`)
+	helper.NewRequestHandlerMock("GET /v1/instances/2f49c2b3", http.StatusOK, `{"data": {"id": "2f49c2b3", "name": "dev-api", "tenant_id": "YOUR_TENANT_ID", "status": "running"}}`).
+		AddResponse(http.StatusOK, `{"data": {"id": "2f49c2b3", "name": "dev-api", "tenant_id": "YOUR_TENANT_ID", "status": "running"}}`)
+	pauseMock := helper.NewRequestHandlerMock("POST /v1/instances/2f49c2b3/pause", http.StatusConflict, `{"errors": [{"message": "The database is current undergoing an operation: updating", "reason": "ongoing-database-operation"}]}`).
+		AddResponse(http.StatusAccepted, `{"data": {"id": "2f49c2b3", "status": "pausing"}}`)
+
+	helper.ExecuteCommand("scheduler run --config schedule.yaml --once --log-format json")
+
+	pauseMock.AssertCalledTimes(2)
+	lines := strings.Split(strings.TrimSpace(helper.PrintErr()), "\n")
+	assert.Len(t, lines, 3)
+	assert.Contains(t, lines[0], `"level":"ERROR","msg":"instance failed","entry":"pause dev","action":"pause","instance_id":"2f49c2b3","instance_name":"dev-api","status":"running","message":"[The database is current undergoing an operation: updating]"}`)
+	assert.Contains(t, lines[1], `"level":"WARN","msg":"retrying entry","entry":"pause dev","action":"pause","attempt":1,"error":"1 of 1 instances could not be paused","retry_in":"0s"}`)
+	assert.Contains(t, lines[2], `"level":"INFO","msg":"instance paused","entry":"pause dev","action":"pause","instance_id":"2f49c2b3","instance_name":"dev-api","status":"pausing"}`)
+}
+
+func TestRunSchedulerOnceWithFailure(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.SetFile("schedule.yaml", `
+retries: 0
+entries:
+  - action: resume
+    schedule: "* * * * *"
+    all: true
+`)
+	helper.NewRequestHandlerMock("GET /v1/instances", http.StatusOK, `{"data": [{"id": "2f49c2b3", "name": "dev-api", "tenant_id": "YOUR_TENANT_ID"}]}`)
+	helper.NewRequestHandlerMock("GET /v1/instances/2f49c2b3", http.StatusOK, `{"data": {"id": "2f49c2b3", "name": "dev-api", "tenant_id": "YOUR_TENANT_ID", "status": "paused"}}`)
+	helper.NewRequestHandlerMock("POST /v1/instances/2f49c2b3/resume", http.StatusConflict, `{"errors": [{"message": "The database is current undergoing an operation: updating", "reason": "ongoing-database-operation"}]}`)
+
+	helper.ExecuteCommand("scheduler run --config schedule.yaml --once")
+
+	err := helper.PrintErr()
+	assert.Contains(t, err, `level=ERROR msg="entry failed" entry="entry 1" action=resume attempts=1 error="1 of 1 instances could not be resumed"`)
+	assert.True(t, strings.HasSuffix(err, "Error: 1 of the due entries failed\n"))
+}
+
+func TestRunSchedulerOnceRetriesTransientAuthFailure(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	tokenRequests := 0
+	authServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		tokenRequests++
+		if tokenRequests == 1 {
+			res.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		res.Write([]byte(`{"access_token":"<token>","expires_in":3600,"token_type":"bearer"}`))
+	}))
+	defer authServer.Close()
+	helper.SetConfigValue("aura.auth-url", authServer.URL)
+	helper.SetFile("schedule.yaml", `
+retries: 1
+retry_interval: 0s
+entries:
+  - name: pause dev
+    action: pause
+    schedule: "* * * * *"
+    selector: ["name=^dev-"]
+`)
+	mockInstances(&helper)
+	pauseMock := helper.NewRequestHandlerMock("POST /v1/instances/2f49c2b3/pause", http.StatusAccepted, `{"data": {"id": "2f49c2b3", "status": "pausing"}}`)
+
+	helper.ExecuteCommand("scheduler run --config schedule.yaml --once")
+
+	pauseMock.AssertCalledTimes(1)
+	err := helper.PrintErr()
+	assert.Contains(t, err, `level=WARN msg="retrying entry" entry="pause dev" action=pause attempt=1 error="can't retrieve authentication token: response status code [503]" retry_in=0s`)
+	assert.Contains(t, err, `level=INFO msg="instance paused" entry="pause dev" action=pause instance_id=2f49c2b3 instance_name=dev-api status=pausing`)
+	assert.NotContains(t, err, "Error:")
+}
+
+func TestRunSchedulerWithInvalidSchedule(t *testing.T) {
+	testCases := map[string]struct {
+		file          string
+		expectedError string
+	}{
+		"unknown action": {
+			file: `
+entries:
+  - name: delete dev
+    action: delete
+    schedule: "0 19 * * *"
+    all: true
+`,
+			expectedError: `Error: invalid action "delete" for delete dev: must be one of "pause" or "resume"`,
+		},
+		"invalid schedule": {
+			file: `
+entries:
+  - name: pause dev
+    action: pause
+    schedule: "0 25 * * *"
+    all: true
+`,
+			expectedError: `Error: invalid schedule for pause dev: invalid hour in schedule "0 25 * * *": invalid value "25": must be between 0 and 23`,
+		},
+		"invalid time zone": {
+			file: `
+time_zone: Mars/Olympus_Mons
+entries:
+  - action: pause
+    schedule: "0 19 * * *"
+    all: true
+`,
+			expectedError: `Error: invalid time_zone "Mars/Olympus_Mons" in schedule.yaml: unknown time zone Mars/Olympus_Mons`,
+		},
+		"no selector": {
+			file: `
+entries:
+  - name: pause dev
+    action: pause
+    schedule: "0 19 * * *"
+`,
+			expectedError: "Error: pause dev has no selector, set all to true to apply it to all instances",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			helper := testutils.NewAuraTestHelper(t)
+			defer helper.Close()
+
+			helper.SetFile("schedule.yaml", testCase.file)
+
+			helper.ExecuteCommand("scheduler run --config schedule.yaml --once")
+
+			helper.AssertErr(testCase.expectedError)
+		})
+	}
+}
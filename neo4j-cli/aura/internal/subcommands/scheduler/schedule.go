@@ -0,0 +1,154 @@
+// Copyright (c) "Neo4j"
+// Neo4j Sweden AB [http://neo4j.com]
+
+package scheduler
+
+import (
+	"bytes"
+	"fmt"
+	"time"
+
+	// Embeds the time zone database, so that time zones also resolve on systems without one
+	_ "time/tzdata"
+
+	"github.com/neo4j/cli/common/clicfg"
+	"github.com/neo4j/cli/common/clierr"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/subcommands/instance"
+	"github.com/spf13/afero"
+	"go.yaml.in/yaml/v3"
+)
+
+const (
+	defaultRetries       = 3
+	defaultRetryInterval = time.Minute
+	defaultConcurrency   = 4
+)
+
+type Schedule struct {
+	// Time zone of entries that do not set their own, defaults to the local time zone
+	TimeZone      string   `yaml:"time_zone"`
+	Retries       *int     `yaml:"retries"`
+	RetryInterval string   `yaml:"retry_interval"`
+	Concurrency   int      `yaml:"concurrency"`
+	Entries       []*Entry `yaml:"entries"`
+
+	retryInterval time.Duration
+}
+
+// An action applied to the instances matching the selector whenever the cron expression matches
+type Entry struct {
+	Name     string   `yaml:"name"`
+	Action   string   `yaml:"action"`
+	Schedule string   `yaml:"schedule"`
+	TimeZone string   `yaml:"time_zone"`
+	Selector []string `yaml:"selector"`
+	All      bool     `yaml:"all"`
+
+	cron     *CronSchedule
+	location *time.Location
+	selector *instance.InstanceSelector
+}
+
+// Past participles of the supported actions, used in log messages
+var entryActions = map[string]string{
+	"pause":  "paused",
+	"resume": "resumed",
+}
+
+func (e *Entry) bulkAction() instance.BulkAction {
+	if e.Action == "resume" {
+		return instance.ResumeAction()
+	}
+	return instance.PauseAction()
+}
+
+// Returns the first time after t the entry is due
+func (e *Entry) next(t time.Time) time.Time {
+	return e.cron.Next(t.In(e.location))
+}
+
+// Reads and validates the schedule file, filling in the defaults
+func ReadSchedule(cfg *clicfg.Config, path string) (*Schedule, error) {
+	data, err := afero.ReadFile(cfg.Aura.Fs(), path)
+	if err != nil {
+		return nil, clierr.NewUsageError("cannot read file %s: %s", path, err)
+	}
+
+	var schedule Schedule
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+	if err := decoder.Decode(&schedule); err != nil {
+		return nil, clierr.NewUsageError("invalid file %s: %s", path, err)
+	}
+
+	if len(schedule.Entries) == 0 {
+		return nil, clierr.NewUsageError("no entries in %s", path)
+	}
+
+	if schedule.Retries == nil {
+		retries := defaultRetries
+		schedule.Retries = &retries
+	}
+	if *schedule.Retries < 0 {
+		return nil, clierr.NewUsageError("invalid retries in %s: must not be negative", path)
+	}
+
+	schedule.retryInterval = defaultRetryInterval
+	if schedule.RetryInterval != "" {
+		schedule.retryInterval, err = time.ParseDuration(schedule.RetryInterval)
+		if err != nil || schedule.retryInterval < 0 {
+			return nil, clierr.NewUsageError("invalid retry_interval %q in %s: must be a duration such as 30s or 5m", schedule.RetryInterval, path)
+		}
+	}
+
+	if schedule.Concurrency == 0 {
+		schedule.Concurrency = defaultConcurrency
+	}
+	if schedule.Concurrency < 0 {
+		return nil, clierr.NewUsageError("invalid concurrency in %s: must be at least 1", path)
+	}
+
+	defaultLocation := time.Local
+	if schedule.TimeZone != "" {
+		defaultLocation, err = time.LoadLocation(schedule.TimeZone)
+		if err != nil {
+			return nil, clierr.NewUsageError("invalid time_zone %q in %s: %s", schedule.TimeZone, path, err)
+		}
+	}
+
+	for i, entry := range schedule.Entries {
+		if entry.Name == "" {
+			entry.Name = fmt.Sprintf("entry %d", i+1)
+		}
+
+		if _, ok := entryActions[entry.Action]; !ok {
+			return nil, clierr.NewUsageError(`invalid action %q for %s: must be one of "pause" or "resume"`, entry.Action, entry.Name)
+		}
+
+		entry.cron, err = ParseCronSchedule(entry.Schedule)
+		if err != nil {
+			return nil, clierr.NewUsageError("invalid schedule for %s: %s", entry.Name, err)
+		}
+
+		entry.location = defaultLocation
+		if entry.TimeZone != "" {
+			entry.location, err = time.LoadLocation(entry.TimeZone)
+			if err != nil {
+				return nil, clierr.NewUsageError("invalid time_zone %q for %s: %s", entry.TimeZone, entry.Name, err)
+			}
+		}
+
+		if len(entry.Selector) == 0 && !entry.All {
+			return nil, clierr.NewUsageError("%s has no selector, set all to true to apply it to all instances", entry.Name)
+		}
+		if len(entry.Selector) > 0 && entry.All {
+			return nil, clierr.NewUsageError("%s cannot have both a selector and all set to true", entry.Name)
+		}
+		entry.selector, err = instance.ParseSelector(entry.Selector)
+		if err != nil {
+			return nil, clierr.NewUsageError("invalid selector for %s: %s", entry.Name, err)
+		}
+	}
+
+	return &schedule, nil
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/neo4j/cli/common/clierr"
 	"github.com/neo4j/cli/neo4j-cli/aura/internal/api"
 	"github.com/neo4j/cli/neo4j-cli/aura/internal/output"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/subcommands/utils"
 )
 
 func NewGetCmd(cfg *clicfg.Config) *cobra.Command {
@@ -22,10 +23,14 @@ func NewGetCmd(cfg *clicfg.Config) *cobra.Command {
 		Long:  "This subcommand returns details about a specific Aura Tenant.",
 		Args:  cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			tenantId := args[0]
+			cmd.SilenceUsage = true
+			tenantId, err := utils.ResolveTenantId(cfg, args[0])
+			if err != nil {
+				return err
+			}
+
 			path := fmt.Sprintf("/tenants/%s", tenantId)
 
-			cmd.SilenceUsage = true
 			resBody, statusCode, err := api.MakeRequest(cfg, path, &api.RequestConfig{
 				Method: http.MethodGet,
 			})
@@ -51,7 +56,7 @@ func NewGetCmd(cfg *clicfg.Config) *cobra.Command {
 }
 
 func postProcessResponseValues(cfg *clicfg.Config, tenantId string, responseData api.ResponseData) ([]string, api.ResponseData, error) {
-	metricsIntegrationEndpointUrl, err := getMetricsIntegrationEndpointUrl(cfg, tenantId)
+	metricsIntegrationEndpointUrl, err := GetMetricsIntegrationEndpointUrl(cfg, tenantId)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -68,7 +73,8 @@ func postProcessResponseValues(cfg *clicfg.Config, tenantId string, responseData
 	}
 }
 
-func getMetricsIntegrationEndpointUrl(cfg *clicfg.Config, tenantId string) (string, error) {
+// Returns the URL of the Prometheus endpoint of the metrics integration of the tenant, empty when it is not available for the tenant
+func GetMetricsIntegrationEndpointUrl(cfg *clicfg.Config, tenantId string) (string, error) {
 	resBody, statusCode, err := api.MakeRequest(cfg, fmt.Sprintf("/tenants/%s/metrics-integration", tenantId), &api.RequestConfig{
 		Method: http.MethodGet,
 	})
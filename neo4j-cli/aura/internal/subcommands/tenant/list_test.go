@@ -5,9 +5,11 @@ package tenant_test
 
 import (
 	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"github.com/neo4j/cli/neo4j-cli/aura/internal/test/testutils"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestListTenants(t *testing.T) {
@@ -63,3 +65,31 @@ func TestListCustomerManagedKeysWithInvalidOutput(t *testing.T) {
 
 	helper.AssertErr("Error: invalid output value specified: invalid")
 }
+
+func TestListTenantsWithUnavailableAuthUrl(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	authServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		res.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer authServer.Close()
+	helper.SetConfigValue("aura.auth-url", authServer.URL)
+	mockHandler := helper.NewRequestHandlerMock("/v1/tenants", http.StatusOK, `{"data": []}`)
+
+	helper.ExecuteCommand("tenant list")
+
+	mockHandler.AssertCalledTimes(0)
+	helper.AssertErr("Error: can't retrieve authentication token: response status code [503]")
+}
+
+func TestListTenantsWithWrongBaseUrl(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.NewRequestHandlerMock("/v1/tenants", http.StatusNotFound, "404 page not found")
+
+	helper.ExecuteCommand("tenant list")
+
+	assert.Contains(t, helper.PrintErr(), "Error: unexpected error [status 404]")
+}
@@ -0,0 +1,26 @@
+// Copyright (c) "Neo4j"
+// Neo4j Sweden AB [http://neo4j.com]
+
+package utils
+
+import "sync"
+
+// Calls task for each index from 0 to n-1, running at most concurrency tasks at the same time
+func RunConcurrently(concurrency int, n int, task func(i int)) {
+	indexes := make(chan int)
+	var wg sync.WaitGroup
+	for range min(concurrency, n) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				task(i)
+			}
+		}()
+	}
+	for i := range n {
+		indexes <- i
+	}
+	close(indexes)
+	wg.Wait()
+}
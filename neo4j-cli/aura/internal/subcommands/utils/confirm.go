@@ -0,0 +1,140 @@
+// Copyright (c) "Neo4j"
+// Neo4j Sweden AB [http://neo4j.com]
+
+package utils
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"regexp"
+
+	"github.com/neo4j/cli/common/clicfg"
+	"github.com/neo4j/cli/common/clierr"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/api"
+	"github.com/spf13/cobra"
+)
+
+const (
+	yesFlag   = "yes"
+	forceFlag = "force"
+)
+
+// Explains the confirmation prompt and the protection of resources, appended to the help of destructive commands
+const ConfirmationHelp = `
+
+When run in a terminal the command asks for confirmation first, unless --yes is set. Resources whose ID or name matches the protected-resources config value, which lists IDs and regular expressions matching names, are refused unless --force is set.`
+
+// The --yes and --force flags of a destructive command
+type ConfirmationFlags struct {
+	yes   bool
+	force bool
+}
+
+func AddConfirmationFlags(cmd *cobra.Command, flags *ConfirmationFlags) {
+	cmd.Flags().BoolVar(&flags.yes, yesFlag, false, "Skips the confirmation prompt")
+
+	cmd.Flags().BoolVar(&flags.force, forceFlag, false, "Allows the operation on a protected resource")
+}
+
+// A destructive operation on a single resource
+type DestructiveOperation struct {
+	// Kind of the resource, e.g. instance
+	Kind string
+	Id   string
+	// The operation, e.g. delete
+	Operation string
+	// Question asked for confirmation, e.g. Delete instance 2f49c2b3?
+	Question string
+	// Returns the name of the resource, only called when resources are protected by name
+	Name func() (string, error)
+}
+
+// Refuses the operation on a protected resource unless --force is set, then asks for confirmation unless --yes is set or the input is not a terminal
+func (flags *ConfirmationFlags) Confirm(cmd *cobra.Command, cfg *clicfg.Config, operation DestructiveOperation) error {
+	if !flags.force && len(cfg.Aura.ProtectedResources()) > 0 {
+		// The name is only retrieved when the ID is not protected itself
+		if err := flags.CheckProtected(cfg, operation.Kind, operation.Id, "", operation.Operation); err != nil {
+			return err
+		}
+		if operation.Name != nil {
+			name, err := operation.Name()
+			if err != nil {
+				return err
+			}
+			if err := flags.CheckProtected(cfg, operation.Kind, operation.Id, name, operation.Operation); err != nil {
+				return err
+			}
+		}
+	}
+	return flags.Ask(cmd, operation.Question)
+}
+
+// Returns an error when the resource is protected and --force is not set
+func (flags *ConfirmationFlags) CheckProtected(cfg *clicfg.Config, kind string, id string, name string, operation string) error {
+	if flags.force || !MatchesAny(cfg.Aura.ProtectedResources(), id, name) {
+		return nil
+	}
+	return clierr.NewUsageError("%s %s is protected by the protected-resources config value, use --force to %s it", kind, id, operation)
+}
+
+// Asks the question unless --yes is set or the input is not a terminal, returning an error when the answer is not yes
+func (flags *ConfirmationFlags) Ask(cmd *cobra.Command, question string) error {
+	if flags.yes || !IsTerminal(cmd) {
+		return nil
+	}
+	confirmed, err := Confirm(cmd, question)
+	if err != nil {
+		return err
+	}
+	if !confirmed {
+		return clierr.NewUsageError("the operation was not confirmed")
+	}
+	return nil
+}
+
+// Returns whether one of the patterns is the ID or a regular expression matching the name
+func MatchesAny(patterns []string, id string, name string) bool {
+	for _, pattern := range patterns {
+		if pattern == id {
+			return true
+		}
+		if name == "" {
+			continue
+		}
+		if matched, err := regexp.MatchString(pattern, name); err == nil && matched {
+			return true
+		}
+	}
+	return false
+}
+
+// Reports whether the command reads from an interactive terminal. Inputs that are not files can report themselves as terminals by implementing IsTerminal, e.g. in tests
+func IsTerminal(cmd *cobra.Command) bool {
+	switch in := cmd.InOrStdin().(type) {
+	case interface{ IsTerminal() bool }:
+		return in.IsTerminal()
+	case *os.File:
+		return isTerminalFd(in.Fd())
+	default:
+		return false
+	}
+}
+
+// Returns a function retrieving the name of the resource at the path, for DestructiveOperation.Name
+func ResourceName(cfg *clicfg.Config, path string, version api.AuraApiVersion) func() (string, error) {
+	return func() (string, error) {
+		resBody, _, err := api.MakeRequest(cfg, path, &api.RequestConfig{
+			Method:  http.MethodGet,
+			Version: version,
+		})
+		if err != nil {
+			return "", err
+		}
+		resource, err := api.ParseBody(resBody).GetSingleOrError()
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprint(resource["name"]), nil
+	}
+}
@@ -0,0 +1,87 @@
+// Copyright (c) "Neo4j"
+// Neo4j Sweden AB [http://neo4j.com]
+
+package utils
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/neo4j/cli/common/clierr"
+	"github.com/spf13/cobra"
+)
+
+// Prints the question and reads a single line answer from the command input. Reading stops at the end of the line,
+// so that consecutive prompts can read consecutive lines of the same input.
+func Prompt(cmd *cobra.Command, question string) (string, error) {
+	cmd.Print(question)
+
+	var answer strings.Builder
+	buf := make([]byte, 1)
+	for {
+		n, err := cmd.InOrStdin().Read(buf)
+		if n == 1 {
+			if buf[0] == '\n' {
+				break
+			}
+			answer.WriteByte(buf[0])
+		}
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return "", err
+		}
+	}
+
+	return strings.TrimSpace(answer.String()), nil
+}
+
+// Asks a yes/no question, anything other than "y" or "yes" is treated as no
+func Confirm(cmd *cobra.Command, question string) (bool, error) {
+	answer, err := Prompt(cmd, question+" [y/N]: ")
+	if err != nil {
+		return false, err
+	}
+
+	switch strings.ToLower(answer) {
+	case "y", "yes":
+		return true, nil
+	default:
+		return false, nil
+	}
+}
+
+// How many times an invalid choice is asked again before giving up
+const chooseAttempts = 3
+
+// Asks to choose one of the options, by number or by value, and returns the index of the chosen option. An empty answer chooses the option at defaultIndex.
+func Choose(cmd *cobra.Command, question string, options []string, defaultIndex int) (int, error) {
+	cmd.Println(question)
+	for i, option := range options {
+		cmd.Printf("  %d) %s\n", i+1, option)
+	}
+
+	for range chooseAttempts {
+		answer, err := Prompt(cmd, fmt.Sprintf("Enter a number [%d]: ", defaultIndex+1))
+		if err != nil {
+			return 0, err
+		}
+		if answer == "" {
+			return defaultIndex, nil
+		}
+		if number, err := strconv.Atoi(answer); err == nil && number >= 1 && number <= len(options) {
+			return number - 1, nil
+		}
+		if index := slices.Index(options, answer); index != -1 {
+			return index, nil
+		}
+		cmd.Printf("Please enter a number between 1 and %d\n", len(options))
+	}
+
+	return 0, clierr.NewUsageError("no valid choice was given for: %s", question)
+}
@@ -0,0 +1,123 @@
+// Copyright (c) "Neo4j"
+// Neo4j Sweden AB [http://neo4j.com]
+
+package utils
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/neo4j/cli/common/clicfg"
+	"github.com/neo4j/cli/common/clierr"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/api"
+)
+
+// Prefix of a value that is always resolved by name, e.g. name:my-db
+const NamePrefix = "name:"
+
+var (
+	shortIdPattern   = regexp.MustCompile(`^[0-9a-f]{8}$`)
+	uuidPattern      = regexp.MustCompile(`^[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}$`)
+	sessionIdPattern = regexp.MustCompile(`^[0-9a-f]{8}-[0-9a-f]{8}$`)
+)
+
+type resource struct {
+	kind      string
+	idPattern *regexp.Regexp
+	// Path and API version of the endpoint listing the resources
+	listPath    string
+	listVersion api.AuraApiVersion
+}
+
+func ResolveInstanceId(cfg *clicfg.Config, value string) (string, error) {
+	return resolveId(cfg, value, resource{
+		kind:      "instance",
+		idPattern: shortIdPattern,
+		listPath:  "/instances",
+	})
+}
+
+func ResolveTenantId(cfg *clicfg.Config, value string) (string, error) {
+	return resolveId(cfg, value, resource{
+		kind:      "tenant",
+		idPattern: uuidPattern,
+		listPath:  "/tenants",
+	})
+}
+
+func ResolveCustomerManagedKeyId(cfg *clicfg.Config, value string) (string, error) {
+	return resolveId(cfg, value, resource{
+		kind:      "customer managed key",
+		idPattern: uuidPattern,
+		listPath:  "/customer-managed-keys",
+	})
+}
+
+func ResolveSessionId(cfg *clicfg.Config, value string) (string, error) {
+	return resolveId(cfg, value, resource{
+		kind:      "session",
+		idPattern: sessionIdPattern,
+		listPath:  "/graph-analytics/sessions",
+	})
+}
+
+// The instance ID has to be resolved first, as Data APIs are listed per instance
+func ResolveGraphQLDataApiId(cfg *clicfg.Config, instanceId string, value string) (string, error) {
+	return resolveId(cfg, value, resource{
+		kind:      "GraphQL Data API",
+		idPattern: shortIdPattern,
+		listPath:  fmt.Sprintf("/instances/%s/data-apis/graphql", instanceId),
+	})
+}
+
+func ResolveDeploymentId(cfg *clicfg.Config, organizationId string, projectId string, value string) (string, error) {
+	return resolveId(cfg, value, resource{
+		kind:        "deployment",
+		idPattern:   uuidPattern,
+		listPath:    fmt.Sprintf("/organizations/%s/projects/%s/fleet-manager/deployments", organizationId, projectId),
+		listVersion: api.AuraApiVersion2,
+	})
+}
+
+// Returns the ID of the resource the value refers to. Values prefixed with name: are always looked up by name. Other values are used as they are when they look like an ID,
+// otherwise they are looked up by name and used as an ID when no resource has that name.
+func resolveId(cfg *clicfg.Config, value string, r resource) (string, error) {
+	name, byName := strings.CutPrefix(value, NamePrefix)
+	if !byName && (value == "" || r.idPattern.MatchString(value)) {
+		return value, nil
+	}
+
+	resBody, _, err := api.MakeRequest(cfg, r.listPath, &api.RequestConfig{
+		Method:  http.MethodGet,
+		Version: r.listVersion,
+	})
+	if err != nil {
+		if byName {
+			return "", err
+		}
+		return value, nil
+	}
+
+	ids := []string{}
+	for _, item := range api.ParseBody(resBody).AsArray() {
+		if item["name"] == name {
+			ids = append(ids, fmt.Sprint(item["id"]))
+		}
+	}
+
+	switch len(ids) {
+	case 0:
+		if byName {
+			return "", clierr.NewUsageError("no %s named %s was found", r.kind, name)
+		}
+		return value, nil
+	case 1:
+		return ids[0], nil
+	default:
+		sort.Strings(ids)
+		return "", clierr.NewUsageError("the name %s matches more than one %s, use one of the IDs instead: %s", name, r.kind, strings.Join(ids, ", "))
+	}
+}
@@ -0,0 +1,15 @@
+//go:build darwin
+
+// Copyright (c) "Neo4j"
+// Neo4j Sweden AB [http://neo4j.com]
+
+package utils
+
+import (
+	"golang.org/x/sys/unix"
+)
+
+func isTerminalFd(fd uintptr) bool {
+	_, err := unix.IoctlGetTermios(int(fd), unix.TIOCGETA)
+	return err == nil
+}
@@ -0,0 +1,15 @@
+//go:build windows
+
+// Copyright (c) "Neo4j"
+// Neo4j Sweden AB [http://neo4j.com]
+
+package utils
+
+import (
+	"golang.org/x/sys/windows"
+)
+
+func isTerminalFd(fd uintptr) bool {
+	var mode uint32
+	return windows.GetConsoleMode(windows.Handle(fd), &mode) == nil
+}
@@ -28,10 +28,12 @@ import (
 type AuraTestHelper struct {
 	mux         *http.ServeMux
 	Server      *httptest.Server
+	in          io.Reader
 	out         *bytes.Buffer
 	err         *bytes.Buffer
 	cfg         string
 	credentials string
+	files       map[string]string
 	fs          afero.Fs
 	t           *testing.T
 }
@@ -47,6 +49,10 @@ func (helper *AuraTestHelper) ExecuteCommand(command string) {
 	fs, err := testfs.GetTestFs(helper.cfg, helper.credentials)
 	assert.Nil(helper.t, err)
 
+	for path, content := range helper.files {
+		assert.Nil(helper.t, afero.WriteFile(fs, path, []byte(content), 0644))
+	}
+
 	helper.fs = fs
 
 	cfg := clicfg.NewConfig(fs, "test")
@@ -57,12 +63,48 @@ func (helper *AuraTestHelper) ExecuteCommand(command string) {
 
 	cmd.SetArgs(args)
 
+	if helper.in != nil {
+		cmd.SetIn(helper.in)
+	}
 	cmd.SetOut(helper.out)
 	cmd.SetErr(helper.err)
 
 	cmd.Execute()
 }
 
+// Sets the input the next command will read from, e.g. answers to prompts
+func (helper *AuraTestHelper) SetInput(input string) {
+	helper.in = strings.NewReader(input)
+}
+
+// Sets the input the next command will read from and makes it report itself as a terminal, so that confirmation prompts are shown
+func (helper *AuraTestHelper) SetTerminalInput(input string) {
+	helper.in = terminalInput{strings.NewReader(input)}
+}
+
+type terminalInput struct {
+	io.Reader
+}
+
+func (terminalInput) IsTerminal() bool {
+	return true
+}
+
+// Adds a file the next commands can read, e.g. a file passed in a flag
+func (helper *AuraTestHelper) SetFile(path string, content string) {
+	if helper.files == nil {
+		helper.files = map[string]string{}
+	}
+	helper.files[path] = content
+}
+
+// Returns the content of a file the last command wrote, e.g. a cache file
+func (helper *AuraTestHelper) ReadFile(path string) string {
+	data, err := afero.ReadFile(helper.fs, path)
+	assert.Nil(helper.t, err)
+	return string(data)
+}
+
 func (helper *AuraTestHelper) SetConfig(cfg string) {
 	helper.cfg = cfg
 }
@@ -135,7 +177,7 @@ func (helper *AuraTestHelper) AssertOutJson(expected string) {
 }
 
 func (helper *AuraTestHelper) AssertConfig(expected string) {
-	file, err := helper.fs.Open(filepath.Join(clicfg.ConfigPrefix, "neo4j", "cli", "config.json"))
+	file, err := helper.fs.Open(filepath.Join(clicfg.ConfigDir(), "config.json"))
 	assert.Nil(helper.t, err)
 	defer file.Close()
 
@@ -149,7 +191,7 @@ func (helper *AuraTestHelper) AssertConfig(expected string) {
 }
 
 func (helper *AuraTestHelper) AssertConfigValue(key string, expected string) {
-	file, err := helper.fs.Open(filepath.Join(clicfg.ConfigPrefix, "neo4j", "cli", "config.json"))
+	file, err := helper.fs.Open(filepath.Join(clicfg.ConfigDir(), "config.json"))
 	assert.Nil(helper.t, err)
 	defer file.Close()
 
@@ -173,7 +215,7 @@ func (helper *AuraTestHelper) AssertConfigValue(key string, expected string) {
 }
 
 func (helper *AuraTestHelper) AssertCredentialsValue(key string, expected string) { // TODO: merge with assertConfig
-	file, err := helper.fs.Open(filepath.Join(clicfg.ConfigPrefix, "neo4j", "cli", "credentials.json"))
+	file, err := helper.fs.Open(filepath.Join(clicfg.ConfigDir(), "credentials.json"))
 	assert.Nil(helper.t, err)
 	defer file.Close()
 
@@ -210,8 +252,10 @@ func (helper *AuraTestHelper) NewRequestHandlerMock(path string, status int, bod
 			assert.Nil(helper.t, err)
 		}
 
+		mock.mu.Lock()
 		requestCount := len(mock.Calls)
 		mock.Calls = append(mock.Calls, call{Method: req.Method, Path: req.URL.Path, Body: unmarshalledBody, QueryParams: req.URL.Query()})
+		mock.mu.Unlock()
 
 		if requestCount >= len(mock.Responses) {
 			res.WriteHeader(404)
@@ -6,6 +6,7 @@ package testutils
 import (
 	"fmt"
 	"net/url"
+	"sync"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -28,6 +29,8 @@ type requestHandlerMock struct {
 	Calls     []call
 	Responses []response
 	t         *testing.T
+	// Guards Calls, as commands may send requests concurrently
+	mu sync.Mutex
 }
 
 func (mock *requestHandlerMock) AddResponse(status int, body string) *requestHandlerMock {
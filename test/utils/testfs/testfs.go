@@ -23,8 +23,8 @@ func GetTestFs(config string, credentials string) (afero.Fs, error) {
 		return fs, nil
 	}
 
-	configPath := filepath.Join(clicfg.ConfigPrefix, "neo4j", "cli", "config.json")
-	credentialsPath := filepath.Join(clicfg.ConfigPrefix, "neo4j", "cli", "credentials.json")
+	configPath := filepath.Join(clicfg.ConfigDir(), "config.json")
+	credentialsPath := filepath.Join(clicfg.ConfigDir(), "credentials.json")
 
 	if err := fs.MkdirAll(filepath.Dir(configPath), 0755); err != nil {
 		return nil, err
@@ -54,7 +54,7 @@ func GetTestFs(config string, credentials string) (afero.Fs, error) {
 }
 
 func GetTestConfig(fs afero.Fs) (string, error) {
-	configPath := filepath.Join(clicfg.ConfigPrefix, "neo4j", "cli", "config.json")
+	configPath := filepath.Join(clicfg.ConfigDir(), "config.json")
 
 	file, err := fs.Open(configPath)
 	if err != nil {